@@ -0,0 +1,3 @@
+module github.com/openxapi/integration-tests/src/binance/go/proxyenv
+
+go 1.24.1
@@ -0,0 +1,56 @@
+// Package proxyenv reads the proxy the REST and WebSocket suites route their traffic through,
+// e.g. to leave from an IP on the API key's allowlist (-2015). A bad proxy fails the run at
+// startup instead of timing out every request.
+package proxyenv
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// DialTimeout bounds the startup reachability check of a configured proxy
+const DialTimeout = 5 * time.Second
+
+// Parse validates a proxy URL such as http://host:3128 or socks5://host:1080
+func Parse(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q (use http, https, socks5 or socks5h)", u.Scheme)
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return nil, fmt.Errorf("proxy URL %q must include host and port", u.Redacted())
+	}
+
+	return u, nil
+}
+
+// FromEnv reads the proxy named by key, validates it and checks it accepts connections.
+// It returns nil when the variable is unset.
+func FromEnv(key string) (*url.URL, error) {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return nil, nil
+	}
+
+	u, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	conn, err := net.DialTimeout("tcp", u.Host, DialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%s: proxy %s is unreachable: %w", key, u.Redacted(), err)
+	}
+	conn.Close()
+
+	return u, nil
+}
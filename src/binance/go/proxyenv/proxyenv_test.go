@@ -0,0 +1,59 @@
+package proxyenv
+
+import (
+	"net"
+	"testing"
+)
+
+const testEnvVar = "PROXYENV_TEST_PROXY"
+
+// TestFromEnv verifies unset, reachable, malformed and unreachable proxies
+func TestFromEnv(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		t.Setenv(testEnvVar, "")
+		if u, err := FromEnv(testEnvVar); u != nil || err != nil {
+			t.Errorf("Expected no proxy and no error, got %v, %v", u, err)
+		}
+	})
+
+	t.Run("Reachable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to start listener: %v", err)
+		}
+		defer listener.Close()
+
+		want := "socks5://" + listener.Addr().String()
+		t.Setenv(testEnvVar, " "+want+" ")
+		u, err := FromEnv(testEnvVar)
+		if err != nil {
+			t.Fatalf("FromEnv() failed: %v", err)
+		}
+		if u.String() != want {
+			t.Errorf("Expected proxy %s, got %s", want, u)
+		}
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		for _, raw := range []string{"ftp://127.0.0.1:21", "http://127.0.0.1", "://bad"} {
+			t.Setenv(testEnvVar, raw)
+			if _, err := FromEnv(testEnvVar); err == nil {
+				t.Errorf("Expected error for proxy %q", raw)
+			}
+		}
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to reserve a port: %v", err)
+		}
+		addr := closed.Addr().String()
+		closed.Close()
+
+		t.Setenv(testEnvVar, "http://"+addr)
+		if _, err := FromEnv(testEnvVar); err == nil {
+			t.Errorf("Expected error for unreachable proxy %s", addr)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+// Package clientpool memoizes one value per key. Each REST module keys its SDK clients by auth
+// configuration, so signers and HTTP transports are built once and shared across tests.
+package clientpool
+
+import "sync"
+
+// Pool holds at most one value per key; it is safe for concurrent use
+type Pool[K comparable, V any] struct {
+	mu     sync.Mutex
+	values map[K]V
+}
+
+// New creates an empty pool
+func New[K comparable, V any]() *Pool[K, V] {
+	return &Pool[K, V]{values: make(map[K]V)}
+}
+
+// Get returns the value stored for key, calling build to create it on first use. Concurrent
+// callers asking for the same key wait for a single build.
+func (p *Pool[K, V]) Get(key K, build func() V) V {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	value, ok := p.values[key]
+	if !ok {
+		value = build()
+		p.values[key] = value
+	}
+	return value
+}
+
+// Len returns the number of values in the pool
+func (p *Pool[K, V]) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.values)
+}
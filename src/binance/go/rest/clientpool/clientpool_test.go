@@ -0,0 +1,63 @@
+package clientpool
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type client struct{ name string }
+
+// TestGet verifies the same key returns the same value and different keys distinct values
+func TestGet(t *testing.T) {
+	pool := New[string, *client]()
+	build := func(name string) func() *client {
+		return func() *client { return &client{name: name} }
+	}
+
+	first := pool.Get("hmac", build("hmac"))
+	second := pool.Get("hmac", build("other"))
+	if first != second {
+		t.Error("Expected the same value for the same key")
+	}
+	if second.name != "hmac" {
+		t.Errorf("Expected the value built on first use, got %q", second.name)
+	}
+
+	other := pool.Get("public", build("public"))
+	if other == first {
+		t.Error("Expected distinct values for different keys")
+	}
+	if got := pool.Len(); got != 2 {
+		t.Errorf("Expected 2 pooled values, got %d", got)
+	}
+}
+
+// TestGetConcurrent verifies parallel callers share a single build
+func TestGetConcurrent(t *testing.T) {
+	pool := New[string, *client]()
+	var builds atomic.Int32
+
+	var wg sync.WaitGroup
+	results := make([]*client, 16)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = pool.Get("hmac", func() *client {
+				builds.Add(1)
+				return &client{name: "hmac"}
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if got := builds.Load(); got != 1 {
+		t.Errorf("Expected 1 build, got %d", got)
+	}
+	for i, c := range results {
+		if c != results[0] {
+			t.Errorf("Parallel get %d returned a different value", i)
+		}
+	}
+}
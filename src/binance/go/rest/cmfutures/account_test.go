@@ -56,6 +56,11 @@ func TestAccountInfo(t *testing.T) {
 					t.Logf("Account info: assets=%d, positions=%d, canTrade=%t, canDeposit=%t, canWithdraw=%t", 
 						len(resp.Assets), len(resp.Positions), *resp.CanTrade, *resp.CanDeposit, *resp.CanWithdraw)
 					
+					// COIN-M documents the top-level updateTime as reserved (0), so only check it when populated
+					if resp.UpdateTime != nil && *resp.UpdateTime != 0 {
+						assertRecentServerTime(t, *resp.UpdateTime, "GetAccount.updateTime")
+					}
+					
 					// Check structure of first asset if any exist
 					if len(resp.Assets) > 0 {
 						firstAsset := resp.Assets[0]
@@ -184,6 +189,213 @@ func TestPositionRisk(t *testing.T) {
 	}
 }
 
+// positionKey identifies a position by symbol and side (BOTH in one-way mode)
+func positionKey(symbol string, side *string) string {
+	if side == nil || *side == "" {
+		return symbol + "/BOTH"
+	}
+	return symbol + "/" + *side
+}
+
+// fetchPositionMismatches fetches account positions and position risk and returns every
+// disagreement in positionAmt or entryPrice for positions present in both
+func fetchPositionMismatches(client *openapi.APIClient, ctx context.Context) ([]string, int, *http.Response, error) {
+	account, httpResp, err := client.FuturesAPI.GetAccountV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return nil, 0, httpResp, err
+	}
+	
+	risks, httpResp, err := client.FuturesAPI.GetPositionRiskV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return nil, 0, httpResp, err
+	}
+	
+	type snapshot struct {
+		positionAmt string
+		entryPrice  string
+	}
+	riskByKey := make(map[string]snapshot, len(risks))
+	for _, risk := range risks {
+		if risk.Symbol == nil || risk.PositionAmt == nil || risk.EntryPrice == nil {
+			continue
+		}
+		riskByKey[positionKey(*risk.Symbol, risk.PositionSide)] = snapshot{*risk.PositionAmt, *risk.EntryPrice}
+	}
+	
+	var mismatches []string
+	compared := 0
+	for _, position := range account.Positions {
+		if position.Symbol == nil || position.PositionAmt == nil || position.EntryPrice == nil {
+			continue
+		}
+		key := positionKey(*position.Symbol, position.PositionSide)
+		risk, ok := riskByKey[key]
+		if !ok {
+			continue
+		}
+		compared++
+		
+		if !decimalEqual(*position.PositionAmt, risk.positionAmt) {
+			mismatches = append(mismatches, fmt.Sprintf("%s positionAmt: account=%s positionRisk=%s",
+				key, *position.PositionAmt, risk.positionAmt))
+		}
+		if !decimalEqual(*position.EntryPrice, risk.entryPrice) {
+			mismatches = append(mismatches, fmt.Sprintf("%s entryPrice: account=%s positionRisk=%s",
+				key, *position.EntryPrice, risk.entryPrice))
+		}
+	}
+	
+	return mismatches, compared, nil, nil
+}
+
+// TestAccountPositionRiskConsistency tests that account positions and position risk report the same positions
+func TestAccountPositionRiskConsistency(t *testing.T) {
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType >= AuthTypeUSER_DATA {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "AccountPositionRiskConsistency", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					mismatches, compared, httpResp, err := fetchPositionMismatches(client, ctx)
+					
+					if handleTestnetError(t, err, httpResp, "AccountPositionRiskConsistency") {
+						return
+					}
+					
+					if err != nil {
+						checkAPIError(t, err, httpResp, "AccountOperation")
+						t.Fatalf("Failed to fetch positions: %v", err)
+					}
+					
+					// A position may change between the two calls, so confirm against a fresh pair of snapshots
+					if len(mismatches) > 0 {
+						for _, mismatch := range mismatches {
+							t.Logf("Discrepancy on first fetch: %s", mismatch)
+						}
+						time.Sleep(500 * time.Millisecond)
+						
+						mismatches, compared, httpResp, err = fetchPositionMismatches(client, ctx)
+						if err != nil {
+							checkAPIError(t, err, httpResp, "AccountOperation")
+							t.Fatalf("Failed to re-fetch positions: %v", err)
+						}
+					}
+					
+					for _, mismatch := range mismatches {
+						t.Errorf("Account and position risk disagree: %s", mismatch)
+					}
+					
+					t.Logf("Compared %d positions present in both account and position risk", compared)
+				})
+			})
+			break
+		}
+	}
+}
+
+// fetchBalanceMismatches fetches account assets and balances and returns every asset whose
+// walletBalance disagrees with its balance, including assets held in only one of the two views
+func fetchBalanceMismatches(client *openapi.APIClient, ctx context.Context) ([]string, int, *http.Response, error) {
+	account, httpResp, err := client.FuturesAPI.GetAccountV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return nil, 0, httpResp, err
+	}
+	
+	balances, httpResp, err := client.FuturesAPI.GetBalanceV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return nil, 0, httpResp, err
+	}
+	
+	balanceByAsset := make(map[string]string, len(balances))
+	for _, balance := range balances {
+		if balance.Asset == nil || balance.Balance == nil {
+			continue
+		}
+		balanceByAsset[*balance.Asset] = *balance.Balance
+	}
+	
+	var mismatches []string
+	compared := 0
+	for _, asset := range account.Assets {
+		if asset.Asset == nil || asset.WalletBalance == nil {
+			continue
+		}
+		balance, ok := balanceByAsset[*asset.Asset]
+		delete(balanceByAsset, *asset.Asset)
+		if !ok {
+			if !decimalEqual(*asset.WalletBalance, "0") {
+				mismatches = append(mismatches, fmt.Sprintf("%s walletBalance=%s missing from balance",
+					*asset.Asset, *asset.WalletBalance))
+			}
+			continue
+		}
+		compared++
+		
+		if !decimalEqual(*asset.WalletBalance, balance) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: account walletBalance=%s balance=%s",
+				*asset.Asset, *asset.WalletBalance, balance))
+		}
+	}
+	for asset, balance := range balanceByAsset {
+		if !decimalEqual(balance, "0") {
+			mismatches = append(mismatches, fmt.Sprintf("%s balance=%s missing from account assets", asset, balance))
+		}
+	}
+	
+	return mismatches, compared, nil, nil
+}
+
+// TestAccountBalanceConsistency tests that account assets and balance report the same wallet balances
+func TestAccountBalanceConsistency(t *testing.T) {
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType >= AuthTypeUSER_DATA {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "AccountBalanceConsistency", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					mismatches, compared, httpResp, err := fetchBalanceMismatches(client, ctx)
+					
+					if handleTestnetError(t, err, httpResp, "AccountBalanceConsistency") {
+						return
+					}
+					
+					if err != nil {
+						checkAPIError(t, err, httpResp, "AccountOperation")
+						t.Fatalf("Failed to fetch balances: %v", err)
+					}
+					
+					// Funding or PnL can settle between the two calls, so confirm against a fresh pair of snapshots
+					if len(mismatches) > 0 {
+						for _, mismatch := range mismatches {
+							t.Logf("Discrepancy on first fetch: %s", mismatch)
+						}
+						time.Sleep(500 * time.Millisecond)
+						
+						mismatches, compared, httpResp, err = fetchBalanceMismatches(client, ctx)
+						if err != nil {
+							checkAPIError(t, err, httpResp, "AccountOperation")
+							t.Fatalf("Failed to re-fetch balances: %v", err)
+						}
+					}
+					
+					for _, mismatch := range mismatches {
+						t.Errorf("Account and balance disagree: %s", mismatch)
+					}
+					
+					t.Logf("Compared %d assets present in both account and balance", compared)
+				})
+			})
+			break
+		}
+	}
+}
+
 // TestChangeLeverage tests changing leverage
 func TestChangeLeverage(t *testing.T) {
 	// Skip if leverage change is not enabled
@@ -370,6 +582,7 @@ func TestChangeMarginType(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "ChangeMarginType", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First, cancel all open orders to prepare for position closure
 					t.Logf("Cancelling all open orders for %s before margin type change", symbol)
@@ -593,6 +806,7 @@ func TestPositionMargin(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "PositionMargin", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// Create a position first to test position margin functionality
 					t.Logf("Creating a position for %s to test position margin", symbol)
@@ -687,7 +901,23 @@ func TestPositionMargin(t *testing.T) {
 						
 						// Wait for all position closures to be processed
 						t.Logf("Waiting for all positions to be closed...")
-						time.Sleep(5 * time.Second)
+						closeErr := eventually(t, 10*time.Second, 500*time.Millisecond, func() (bool, error) {
+							account, _, err := client.FuturesAPI.GetAccountV1(ctx).
+								Timestamp(generateTimestamp()).
+								Execute()
+							if err != nil {
+								return false, err
+							}
+							for _, position := range account.Positions {
+								if position.PositionAmt != nil && !decimalEqual(*position.PositionAmt, "0") {
+									return false, nil
+								}
+							}
+							return true, nil
+						})
+						if closeErr != nil {
+							t.Logf("Positions not confirmed closed: %v", closeErr)
+						}
 						
 						// Set margin type to isolated for position margin testing
 						t.Logf("Setting margin type to ISOLATED for position margin testing")
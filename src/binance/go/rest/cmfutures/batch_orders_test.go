@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// BatchOrderSpec is one entry of the batchOrders parameter used by the batch create and modify endpoints
+type BatchOrderSpec struct {
+	Symbol            string `json:"symbol"`
+	Side              string `json:"side"`
+	Type              string `json:"type,omitempty"`
+	Quantity          string `json:"quantity"`
+	Price             string `json:"price,omitempty"`
+	TimeInForce       string `json:"timeInForce,omitempty"`
+	NewClientOrderId  string `json:"newClientOrderId,omitempty"`
+	OrderId           int64  `json:"orderId,omitempty"`
+	OrigClientOrderId string `json:"origClientOrderId,omitempty"`
+	// Timestamp signs a modification entry on its own, as the coin-margined batch modify expects
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+// maxBatchOrders is the exchange limit on entries per batch request
+const maxBatchOrders = 5
+
+// buildBatchPayload validates the specs and marshals them into the batchOrders JSON string.
+// Specs with OrderId or OrigClientOrderId are treated as modifications, all others as new orders.
+func buildBatchPayload(specs []BatchOrderSpec) (string, error) {
+	if len(specs) == 0 || len(specs) > maxBatchOrders {
+		return "", fmt.Errorf("batch must contain 1-%d orders, got %d", maxBatchOrders, len(specs))
+	}
+
+	for i, spec := range specs {
+		if spec.Symbol == "" || spec.Side == "" || spec.Quantity == "" {
+			return "", fmt.Errorf("batch order %d: symbol, side and quantity are required", i)
+		}
+
+		if spec.OrderId != 0 || spec.OrigClientOrderId != "" {
+			if spec.Price == "" {
+				return "", fmt.Errorf("batch order %d: price is required to modify an order", i)
+			}
+			continue
+		}
+
+		if spec.Type == "" {
+			return "", fmt.Errorf("batch order %d: type is required for a new order", i)
+		}
+		if spec.Type == "LIMIT" && (spec.Price == "" || spec.TimeInForce == "") {
+			return "", fmt.Errorf("batch order %d: price and timeInForce are required for LIMIT orders", i)
+		}
+	}
+
+	payload, err := json.Marshal(specs)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal batch orders: %w", err)
+	}
+	return string(payload), nil
+}
+
+// TestBuildBatchPayload verifies the batchOrders JSON uses Binance field names and rejects incomplete specs
+func TestBuildBatchPayload(t *testing.T) {
+	payload, err := buildBatchPayload([]BatchOrderSpec{
+		{Symbol: "BTCUSD_PERP", Side: "BUY", Type: "LIMIT", Quantity: "1", Price: "100.0", TimeInForce: "GTC", NewClientOrderId: "batch_1"},
+		{Symbol: "BTCUSD_PERP", Side: "SELL", OrderId: 12345, Quantity: "1", Price: "101.0", Timestamp: 1760000000000},
+	})
+	if err != nil {
+		t.Fatalf("buildBatchPayload failed: %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(payload), &decoded); err != nil {
+		t.Fatalf("Payload is not a JSON array: %v", err)
+	}
+	if len(decoded) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(decoded))
+	}
+
+	expectedKeys := [][]string{
+		{"symbol", "side", "type", "quantity", "price", "timeInForce", "newClientOrderId"},
+		{"symbol", "side", "orderId", "quantity", "price", "timestamp"},
+	}
+	for i, keys := range expectedKeys {
+		if len(decoded[i]) != len(keys) {
+			t.Fatalf("Entry %d has keys %v, expected exactly %v", i, decoded[i], keys)
+		}
+		for _, key := range keys {
+			if _, ok := decoded[i][key]; !ok {
+				t.Fatalf("Entry %d is missing %q: %s", i, key, payload)
+			}
+		}
+	}
+	if decoded[1]["orderId"] != float64(12345) {
+		t.Fatalf("orderId should be encoded as a number, got %v", decoded[1]["orderId"])
+	}
+	if decoded[1]["timestamp"] != float64(1760000000000) {
+		t.Fatalf("Per-entry timestamp should be encoded as a number, got %v", decoded[1]["timestamp"])
+	}
+
+	invalid := map[string][]BatchOrderSpec{
+		"empty batch":          {},
+		"too many orders":      make([]BatchOrderSpec, maxBatchOrders+1),
+		"missing symbol":       {{Side: "BUY", Type: "LIMIT", Quantity: "1", Price: "100.0", TimeInForce: "GTC"}},
+		"missing type":         {{Symbol: "BTCUSD_PERP", Side: "BUY", Quantity: "1", Price: "100.0"}},
+		"limit without tif":    {{Symbol: "BTCUSD_PERP", Side: "BUY", Type: "LIMIT", Quantity: "1", Price: "100.0"}},
+		"modify without price": {{Symbol: "BTCUSD_PERP", Side: "BUY", OrderId: 1, Quantity: "1"}},
+	}
+	for name, specs := range invalid {
+		if _, err := buildBatchPayload(specs); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
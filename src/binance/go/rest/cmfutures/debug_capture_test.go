@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	openapi "github.com/openxapi/binance-go/rest/cmfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/httpdebug"
+)
+
+// debugClient returns a copy of client whose requests and responses are dumped to the sub-test
+// log with credentials scrubbed. Use it for the single request under investigation; client
+// itself is left untouched.
+func debugClient(t *testing.T, client *openapi.APIClient) *openapi.APIClient {
+	return debugClientLogf(t.Logf, client)
+}
+
+// debugClientLogf is debugClient with an explicit log sink
+func debugClientLogf(logf func(format string, args ...interface{}), client *openapi.APIClient) *openapi.APIClient {
+	cfg := copyConfiguration(client.GetConfig())
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+	cfg.HTTPClient.Transport = &httpdebug.Transport{Base: cfg.HTTPClient.Transport, Logf: logf}
+	return openapi.NewAPIClient(cfg)
+}
+
+// copyConfiguration returns a copy of cfg whose servers, default headers and HTTP client can be
+// changed without touching cfg; the underlying transport is still shared
+func copyConfiguration(cfg *openapi.Configuration) *openapi.Configuration {
+	copied := *cfg
+	copied.Servers = append(openapi.ServerConfigurations(nil), cfg.Servers...)
+	copied.DefaultHeader = make(map[string]string, len(cfg.DefaultHeader))
+	for name, value := range cfg.DefaultHeader {
+		copied.DefaultHeader[name] = value
+	}
+	if cfg.HTTPClient != nil {
+		httpClient := *cfg.HTTPClient
+		copied.HTTPClient = &httpClient
+	}
+	return &copied
+}
+
+// TestDebugClient tests that only requests made through the debug copy are dumped
+func TestDebugClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"serverTime":1760000000000}`))
+	}))
+	defer server.Close()
+
+	cfg := openapi.NewConfiguration()
+	cfg.Servers = openapi.ServerConfigurations{{URL: server.URL}}
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+	client := openapi.NewAPIClient(cfg)
+
+	var captured []string
+	logf := func(format string, args ...interface{}) {
+		captured = append(captured, fmt.Sprintf(format, args...))
+	}
+
+	debug := debugClientLogf(logf, client)
+	if _, _, err := debug.FuturesAPI.GetTimeV1(context.Background()).Execute(); err != nil {
+		t.Fatalf("Request through the debug client failed: %v", err)
+	}
+	if len(captured) != 2 {
+		t.Fatalf("Expected a request and a response dump, got %d: %v", len(captured), captured)
+	}
+	if !strings.Contains(captured[0], "/v1/time") || !strings.Contains(captured[1], "serverTime") {
+		t.Errorf("Unexpected dumps: %v", captured)
+	}
+
+	if _, _, err := client.FuturesAPI.GetTimeV1(context.Background()).Execute(); err != nil {
+		t.Fatalf("Request through the original client failed: %v", err)
+	}
+	if len(captured) != 2 {
+		t.Errorf("Expected the original client not to be dumped, got %d dumps", len(captured))
+	}
+	if _, wrapped := client.GetConfig().HTTPClient.Transport.(*httpdebug.Transport); wrapped {
+		t.Error("Expected the original client's transport to be left unwrapped")
+	}
+}
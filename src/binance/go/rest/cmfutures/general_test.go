@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -140,4 +142,152 @@ func TestExchangeInfo(t *testing.T) {
 			break
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestSymbolValidation verifies that a rejected override names whichever of the two symbol env vars set it
+func TestSymbolValidation(t *testing.T) {
+	statuses := map[string]string{
+		"BTCUSD_PERP":   "TRADING",
+		"BTCUSD_250328": "PENDING_TRADING",
+	}
+
+	for _, envVar := range []string{"BINANCE_TEST_CMFUTURES_SYMBOL", "BINANCE_TEST_CMFUTURES_SYMBOL2"} {
+		if err := validateSymbolStatus(envVar, "BTCUSD_PERP", statuses); err != nil {
+			t.Fatalf("Expected BTCUSD_PERP to be valid for %s, got: %v", envVar, err)
+		}
+		err := validateSymbolStatus(envVar, "BTCUSD_250328", statuses)
+		if err == nil || !strings.Contains(err.Error(), envVar+`="BTCUSD_250328"`) {
+			t.Errorf("Expected BTCUSD_250328 to be rejected naming %s, got: %v", envVar, err)
+		}
+	}
+}
+
+// TestNonTradingReason verifies which contract states make requireTrading skip
+func TestNonTradingReason(t *testing.T) {
+	statuses := map[string]string{
+		"BTCUSD_PERP":   "TRADING",
+		"ETHUSD_241227": "DELIVERING",
+	}
+
+	tests := []struct {
+		symbol   string
+		wantSkip string
+	}{
+		{"BTCUSD_PERP", ""},
+		{"ETHUSD_241227", "contract status DELIVERING"},
+		{"DOESNOTEXIST_PERP", "not listed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			reason := nonTradingReason(tt.symbol, statuses)
+			if tt.wantSkip == "" {
+				if reason != "" {
+					t.Fatalf("Expected %s to be tradable, got skip reason: %s", tt.symbol, reason)
+				}
+				return
+			}
+			if !strings.Contains(reason, tt.wantSkip) || !strings.Contains(reason, tt.symbol) {
+				t.Fatalf("Skip reason should mention %q and the symbol, got: %q", tt.wantSkip, reason)
+			}
+		})
+	}
+}
+
+// TestFirstOutOfOrder verifies the ordering check behind assertAscendingByTime
+func TestFirstOutOfOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		times []int64
+		want  int
+	}{
+		{"empty", nil, -1},
+		{"single", []int64{1700000000000}, -1},
+		{"ascending", []int64{1700000000000, 1700000001000, 1700000002000}, -1},
+		{"equal adjacent", []int64{1700000000000, 1700000000000, 1700000001000}, -1},
+		{"descending", []int64{1700000002000, 1700000001000, 1700000000000}, 1},
+		{"late regression", []int64{1700000000000, 1700000002000, 1700000001000}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firstOutOfOrder(tt.times); got != tt.want {
+				t.Errorf("firstOutOfOrder(%v) = %d, want %d", tt.times, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEventually verifies the success, timeout, error and cancellation paths of the polling helper
+func TestEventually(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		calls := 0
+		err := eventually(t, time.Second, 10*time.Millisecond, func() (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		if err != nil {
+			t.Fatalf("Expected success, got %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("Expected 3 polls, got %d", calls)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		calls := 0
+		start := time.Now()
+		err := eventually(t, 100*time.Millisecond, 10*time.Millisecond, func() (bool, error) {
+			calls++
+			return false, nil
+		})
+		if !errors.Is(err, errEventuallyTimeout) {
+			t.Fatalf("Expected timeout error, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed > time.Second {
+			t.Errorf("Expected to give up at the 100ms deadline, took %v", elapsed)
+		}
+		if calls < 2 {
+			t.Errorf("Expected repeated polls before timing out, got %d", calls)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		wantErr := errors.New("request failed")
+		calls := 0
+		err := eventually(t, time.Second, 10*time.Millisecond, func() (bool, error) {
+			calls++
+			return false, wantErr
+		})
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("Expected condition error, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("Expected polling to stop on the first error, got %d polls", calls)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := eventuallyCtx(ctx, time.Second, 10*time.Millisecond, func() (bool, error) {
+			return false, nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+// TestServerConfig tests that setupClient targets the COIN-M futures testnet; no setting points the suite at mainnet
+func TestServerConfig(t *testing.T) {
+	client, _ := setupClient(TestConfig{Name: "Server Config", AuthType: AuthTypeNONE})
+
+	servers := client.GetConfig().Servers
+	if len(servers) == 0 {
+		t.Fatal("Client has no server configuration")
+	}
+	if got, want := servers[0].URL, "https://testnet.binancefuture.com"; got != want {
+		t.Fatalf("Active server URL is %s, want the testnet %s", got, want)
+	}
+}
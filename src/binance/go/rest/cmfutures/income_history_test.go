@@ -2,12 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/cmfutures"
 )
 
+// downloadReadyTimeout bounds how long async download tests wait for a prepared file
+const downloadReadyTimeout = 15 * time.Second
+
+// downloadReady reports whether an async download status means the file is prepared
+func downloadReady(status *string) bool {
+	return status != nil && *status == "completed"
+}
+
 // TestIncomeHistory tests getting income history
 func TestIncomeHistory(t *testing.T) {
 	configs := getTestConfigs()
@@ -30,6 +42,15 @@ func TestIncomeHistory(t *testing.T) {
 					}
 					
 					t.Logf("Income history: count=%d", len(resp))
+					assertEmptySlice(t, resp, "income")
+					
+					times := make([]int64, 0, len(resp))
+					for _, income := range resp {
+						if income.Time != nil {
+							times = append(times, *income.Time)
+						}
+					}
+					assertAscendingByTime(t, times, "income.time")
 					
 					// Check structure of first income entry if any exist
 					if len(resp) > 0 {
@@ -60,6 +81,80 @@ func TestIncomeHistory(t *testing.T) {
 	}
 }
 
+// deliveryIncomeTypes are income types only produced around quarterly deliveries
+var deliveryIncomeTypes = []string{"DELIVERED_SETTELMENT", "INSURANCE_CLEAR"}
+
+// TestDeliveryIncomeTypes tests that delivery-specific income records parse with a complete schema
+func TestDeliveryIncomeTypes(t *testing.T) {
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType >= AuthTypeUSER_DATA {
+			t.Run(config.Name, func(t *testing.T) {
+				for _, incomeType := range deliveryIncomeTypes {
+					incomeType := incomeType
+					t.Run(incomeType, func(t *testing.T) {
+						testEndpoint(t, config, "DeliveryIncomeTypes", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+							// Cover at least one quarterly delivery
+							endTime := time.Now()
+							startTime := endTime.AddDate(0, 0, -90)
+							
+							resp, httpResp, err := client.FuturesAPI.GetIncomeV1(ctx).
+								IncomeType(incomeType).
+								StartTime(startTime.UnixMilli()).
+								EndTime(endTime.UnixMilli()).
+								Limit(1000).
+								Timestamp(generateTimestamp()).
+								Execute()
+							
+							if handleTestnetError(t, err, httpResp, "DeliveryIncomeTypes") {
+								return
+							}
+							
+							if err != nil {
+								checkAPIError(t, err, httpResp, "IncomeHistoryOperation")
+								t.Fatalf("Income history for %s failed: %v", incomeType, err)
+							}
+							
+							// These records only exist around delivery events
+							if len(resp) == 0 {
+								t.Skipf("No %s income records in the last 90 days", incomeType)
+							}
+							
+							for i, income := range resp {
+								if income.IncomeType == nil || *income.IncomeType != incomeType {
+									t.Errorf("Record %d: expected incomeType %s, got %v", i, incomeType, income.IncomeType)
+								}
+								if income.Symbol == nil || *income.Symbol == "" {
+									t.Errorf("Record %d: missing symbol", i)
+								}
+								if income.Asset == nil || *income.Asset == "" {
+									t.Errorf("Record %d: missing asset", i)
+								}
+								if income.Income == nil {
+									t.Errorf("Record %d: missing income", i)
+								} else if _, err := strconv.ParseFloat(*income.Income, 64); err != nil {
+									t.Errorf("Record %d: income %q is not numeric: %v", i, *income.Income, err)
+								}
+								if income.Time == nil || *income.Time <= 0 {
+									t.Errorf("Record %d: missing or invalid time", i)
+								}
+							}
+							
+							if t.Failed() {
+								return
+							}
+							first := resp[0]
+							t.Logf("%s: count=%d, first symbol=%s, income=%s %s, time=%d", incomeType, len(resp),
+								*first.Symbol, *first.Income, *first.Asset, *first.Time)
+						})
+					})
+				}
+			})
+			break
+		}
+	}
+}
+
 // TestIncomeAsync tests getting download id for futures transaction history
 func TestIncomeAsync(t *testing.T) {
 	configs := getTestConfigs()
@@ -134,14 +229,24 @@ func TestIncomeAsyncDownload(t *testing.T) {
 					
 					downloadId := *asyncResp.DownloadId
 					
-					// Wait a bit for the download to be prepared
-					time.Sleep(2 * time.Second)
-					
-					req := client.FuturesAPI.GetIncomeAsynIdV1(ctx).
+					resp, httpResp, err := client.FuturesAPI.GetIncomeAsynIdV1(ctx).
 						DownloadId(downloadId).
-						Timestamp(generateTimestamp())
-					
-					resp, httpResp, err := req.Execute()
+						Timestamp(generateTimestamp()).
+						Execute()
+					
+					// Poll while the download is being prepared; a pending status is still a valid response
+					if err == nil && !downloadReady(resp.Status) {
+						pollErr := eventually(t, downloadReadyTimeout, time.Second, func() (bool, error) {
+							resp, httpResp, err = client.FuturesAPI.GetIncomeAsynIdV1(ctx).
+								DownloadId(downloadId).
+								Timestamp(generateTimestamp()).
+								Execute()
+							return err == nil && downloadReady(resp.Status), err
+						})
+						if errors.Is(pollErr, errEventuallyTimeout) {
+							t.Logf("Download %s not ready within %v", downloadId, downloadReadyTimeout)
+						}
+					}
 					
 					if handleTestnetError(t, err, httpResp, "IncomeAsyncDownload") {
 						return
@@ -163,9 +268,9 @@ func TestIncomeAsyncDownload(t *testing.T) {
 					t.Logf("Income async download: downloadId=%s, status=%s", 
 						*resp.DownloadId, *resp.Status)
 					
-					// If download is ready, check the URL
-					if resp.Status != nil && *resp.Status == "completed" && resp.Url != nil {
-						t.Logf("Download URL: %s", *resp.Url)
+					// A completed download must carry a usable link that has not already expired
+					if downloadReady(resp.Status) {
+						assertDownloadLink(t, "Income", downloadId, resp.Url, resp.ExpirationTimestamp)
 					}
 				})
 			})
@@ -248,14 +353,24 @@ func TestOrderAsyncDownload(t *testing.T) {
 					
 					downloadId := *asyncResp.DownloadId
 					
-					// Wait a bit for the download to be prepared
-					time.Sleep(2 * time.Second)
-					
-					req := client.FuturesAPI.GetOrderAsynIdV1(ctx).
+					resp, httpResp, err := client.FuturesAPI.GetOrderAsynIdV1(ctx).
 						DownloadId(downloadId).
-						Timestamp(generateTimestamp())
-					
-					resp, httpResp, err := req.Execute()
+						Timestamp(generateTimestamp()).
+						Execute()
+					
+					// Poll while the download is being prepared; a pending status is still a valid response
+					if err == nil && !downloadReady(resp.Status) {
+						pollErr := eventually(t, downloadReadyTimeout, time.Second, func() (bool, error) {
+							resp, httpResp, err = client.FuturesAPI.GetOrderAsynIdV1(ctx).
+								DownloadId(downloadId).
+								Timestamp(generateTimestamp()).
+								Execute()
+							return err == nil && downloadReady(resp.Status), err
+						})
+						if errors.Is(pollErr, errEventuallyTimeout) {
+							t.Logf("Download %s not ready within %v", downloadId, downloadReadyTimeout)
+						}
+					}
 					
 					if handleTestnetError(t, err, httpResp, "OrderAsyncDownload") {
 						return
@@ -277,9 +392,9 @@ func TestOrderAsyncDownload(t *testing.T) {
 					t.Logf("Order async download: downloadId=%s, status=%s", 
 						*resp.DownloadId, *resp.Status)
 					
-					// If download is ready, check the URL
-					if resp.Status != nil && *resp.Status == "completed" && resp.Url != nil {
-						t.Logf("Download URL: %s", *resp.Url)
+					// A completed download must carry a usable link that has not already expired
+					if downloadReady(resp.Status) {
+						assertDownloadLink(t, "Order", downloadId, resp.Url, resp.ExpirationTimestamp)
 					}
 				})
 			})
@@ -362,14 +477,24 @@ func TestTradeAsyncDownload(t *testing.T) {
 					
 					downloadId := *asyncResp.DownloadId
 					
-					// Wait a bit for the download to be prepared
-					time.Sleep(2 * time.Second)
-					
-					req := client.FuturesAPI.GetTradeAsynIdV1(ctx).
+					resp, httpResp, err := client.FuturesAPI.GetTradeAsynIdV1(ctx).
 						DownloadId(downloadId).
-						Timestamp(generateTimestamp())
-					
-					resp, httpResp, err := req.Execute()
+						Timestamp(generateTimestamp()).
+						Execute()
+					
+					// Poll while the download is being prepared; a pending status is still a valid response
+					if err == nil && !downloadReady(resp.Status) {
+						pollErr := eventually(t, downloadReadyTimeout, time.Second, func() (bool, error) {
+							resp, httpResp, err = client.FuturesAPI.GetTradeAsynIdV1(ctx).
+								DownloadId(downloadId).
+								Timestamp(generateTimestamp()).
+								Execute()
+							return err == nil && downloadReady(resp.Status), err
+						})
+						if errors.Is(pollErr, errEventuallyTimeout) {
+							t.Logf("Download %s not ready within %v", downloadId, downloadReadyTimeout)
+						}
+					}
 					
 					if handleTestnetError(t, err, httpResp, "TradeAsyncDownload") {
 						return
@@ -391,13 +516,49 @@ func TestTradeAsyncDownload(t *testing.T) {
 					t.Logf("Trade async download: downloadId=%s, status=%s", 
 						*resp.DownloadId, *resp.Status)
 					
-					// If download is ready, check the URL
-					if resp.Status != nil && *resp.Status == "completed" && resp.Url != nil {
-						t.Logf("Download URL: %s", *resp.Url)
+					// A completed download must carry a usable link that has not already expired
+					if downloadReady(resp.Status) {
+						assertDownloadLink(t, "Trade", downloadId, resp.Url, resp.ExpirationTimestamp)
 					}
 				})
 			})
 			break
 		}
 	}
-}
\ No newline at end of file
+}
+
+// parseDownloadURL parses a download link, which the API may return without a scheme
+func parseDownloadURL(link string) (*url.URL, error) {
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Host == "" {
+		return nil, errors.New("no host")
+	}
+	return parsed, nil
+}
+
+// assertDownloadLink checks that a completed download carries a parseable link that has not
+// already expired. The status schema has no asset or margin-type field, so the coin-margined
+// origin cannot be asserted beyond the dapi route used.
+func assertDownloadLink(t *testing.T, name, downloadId string, link *string, expiration *int64) {
+	t.Helper()
+	
+	if link == nil || *link == "" {
+		t.Fatalf("%s download %s is completed but has no url", name, downloadId)
+	}
+	parsed, err := parseDownloadURL(*link)
+	if err != nil {
+		t.Fatalf("%s download url %q is not a valid link: %v", name, *link, err)
+	}
+	if expiration != nil && time.UnixMilli(*expiration).Before(time.Now()) {
+		t.Errorf("%s download %s expired at %s right after completing",
+			name, downloadId, time.UnixMilli(*expiration).Format(time.RFC3339))
+	}
+	
+	t.Logf("Download URL host: %s, expires: %v", parsed.Host, expiration)
+}
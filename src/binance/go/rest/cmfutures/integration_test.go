@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"os"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/cmfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // AuthType represents the type of authentication
@@ -146,6 +148,9 @@ func setupClient(config TestConfig) (*openapi.APIClient, context.Context) {
 		},
 	}
 
+	// Each client owns its transport, routed through BINANCE_TEST_HTTP_PROXY when set
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+
 	// Create client
 	client := openapi.NewAPIClient(cfg)
 	ctx := context.Background()
@@ -248,7 +253,7 @@ func loadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
 func testEndpoint(t *testing.T, config TestConfig, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
 	rateLimiter.WaitForRateLimit()
 
-	client, ctx := setupClient(config)
+	client, ctx := pooledClientFor(config)
 	
 	// Create a context with timeout for the HTTP requests
 	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
@@ -289,6 +294,40 @@ func getCurrentPrice(client *openapi.APIClient, ctx context.Context, symbol stri
 	return strconv.ParseFloat(*firstPrice.Price, 64)
 }
 
+// getTickSizeForSymbol returns the PRICE_FILTER tick size and min price for symbol from exchange info
+func getTickSizeForSymbol(client *openapi.APIClient, ctx context.Context, symbol string) (float64, float64, error) {
+	resp, _, err := client.FuturesAPI.GetExchangeInfoV1(ctx).Execute()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, symbolInfo := range resp.Symbols {
+		if symbolInfo.Symbol == nil || *symbolInfo.Symbol != symbol {
+			continue
+		}
+		for _, filter := range symbolInfo.Filters {
+			if filter.FilterType == nil || *filter.FilterType != "PRICE_FILTER" || filter.TickSize == nil {
+				continue
+			}
+			tickSize, err := strconv.ParseFloat(*filter.TickSize, 64)
+			if err != nil || tickSize <= 0 {
+				return 0, 0, fmt.Errorf("invalid tickSize %q for %s", *filter.TickSize, symbol)
+			}
+			var minPrice float64
+			if filter.MinPrice != nil {
+				minPrice, _ = strconv.ParseFloat(*filter.MinPrice, 64)
+			}
+			return tickSize, minPrice, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("no PRICE_FILTER found for %s", symbol)
+}
+
+// roundToTickSize rounds price to the nearest whole number of ticks above minPrice
+func roundToTickSize(price, tickSize, minPrice float64) float64 {
+	return minPrice + math.Round((price-minPrice)/tickSize)*tickSize
+}
+
 // checkAPIError checks if an error is an API error and logs it
 // NEVER skips 400 Bad Request errors - these need investigation
 func checkAPIError(t *testing.T, err error, httpResp *http.Response, testName string) {
@@ -320,9 +359,30 @@ func checkAPIError(t *testing.T, err error, httpResp *http.Response, testName st
 	}
 }
 
-// generateTimestamp generates a timestamp for API requests
+// generateTimestamp generates a timestamp for API requests from the shared request clock
 func generateTimestamp() int64 {
-	return time.Now().UnixMilli()
+	return timestamp.Now()
+}
+
+// syncRequestClock aligns the shared request clock with the configured server, so signed requests
+// are not rejected for clock drift (-1021). The local clock is kept if the server time is unavailable.
+func syncRequestClock() {
+	client, ctx := setupClient(TestConfig{Name: "Request Clock"})
+	err := timestamp.Sync(func() (int64, error) {
+		resp, _, err := client.FuturesAPI.GetTimeV1(ctx).Execute()
+		if err != nil {
+			return 0, err
+		}
+		if resp.ServerTime == nil {
+			return 0, fmt.Errorf("response has no serverTime")
+		}
+		return *resp.ServerTime, nil
+	})
+	if err != nil {
+		fmt.Printf("Using the local clock for request timestamps: %v\n", err)
+		return
+	}
+	fmt.Printf("Request clock offset from server: %d ms\n", timestamp.Offset())
 }
 
 // TestFullIntegrationSuite runs all integration tests
@@ -415,6 +475,7 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Ping", Function: TestPing, AuthRequired: AuthTypeNONE, Category: "General"},
 		{Name: "Server Time", Function: TestServerTime, AuthRequired: AuthTypeNONE, Category: "General"},
 		{Name: "Exchange Info", Function: TestExchangeInfo, AuthRequired: AuthTypeNONE, Category: "General"},
+		{Name: "Custom User Agent", Function: TestCustomUserAgent, AuthRequired: AuthTypeNONE, Category: "General"},
 		
 		// Market Data API Tests
 		{Name: "Order Book Depth", Function: TestOrderBookDepth, AuthRequired: AuthTypeNONE, Category: "MarketData"},
@@ -429,6 +490,7 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "24hr Ticker", Function: Test24hrTicker, AuthRequired: AuthTypeNONE, Category: "MarketData"},
 		{Name: "Ticker Price", Function: TestTickerPrice, AuthRequired: AuthTypeNONE, Category: "MarketData"},
 		{Name: "Ticker Book", Function: TestTickerBook, AuthRequired: AuthTypeNONE, Category: "MarketData"},
+		{Name: "Book Ticker By Pair", Function: TestBookTickerByPair, AuthRequired: AuthTypeNONE, Category: "MarketData"},
 		{Name: "Premium Index", Function: TestPremiumIndex, AuthRequired: AuthTypeNONE, Category: "MarketData"},
 		{Name: "Funding Rate", Function: TestFundingRate, AuthRequired: AuthTypeNONE, Category: "MarketData"},
 		{Name: "Funding Info", Function: TestFundingInfo, AuthRequired: AuthTypeNONE, Category: "MarketData"},
@@ -448,15 +510,21 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Batch Orders", Function: TestBatchOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Batch Update Orders", Function: TestBatchUpdateOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Batch Cancel Orders", Function: TestBatchCancelOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Batch Cancel OrderIdList", Function: TestBatchCancelOrderIdList, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "List Param Encoding Accepted", Function: TestListParamEncodingAccepted, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Countdown Cancel All", Function: TestCountdownCancelAll, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Countdown Cancel All Fires", Function: TestCountdownCancelAllFires, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Order Amendment", Function: TestOrderAmendment, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Hedge Mode Orders", Function: TestHedgeModeOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "User Trades", Function: TestUserTrades, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Commission Rate", Function: TestCommissionRate, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		
 		// Account/Position Management Tests
 		{Name: "Account Info", Function: TestAccountInfo, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Account Balance", Function: TestAccountBalance, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
+		{Name: "Account Balance Consistency", Function: TestAccountBalanceConsistency, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Position Risk", Function: TestPositionRisk, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
+		{Name: "Account Position Risk Consistency", Function: TestAccountPositionRiskConsistency, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Change Leverage", Function: TestChangeLeverage, AuthRequired: AuthTypeTRADE, Category: "Account"},
 		{Name: "Leverage Bracket", Function: TestLeverageBracket, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Leverage Bracket V2", Function: TestLeverageBracketV2, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
@@ -470,6 +538,7 @@ func (suite *TestSuite) initializeTests() {
 		
 		// Income/History Tests
 		{Name: "Income History", Function: TestIncomeHistory, AuthRequired: AuthTypeUSER_DATA, Category: "Income"},
+		{Name: "Delivery Income Types", Function: TestDeliveryIncomeTypes, AuthRequired: AuthTypeUSER_DATA, Category: "Income"},
 		{Name: "Income Async", Function: TestIncomeAsync, AuthRequired: AuthTypeUSER_DATA, Category: "Income"},
 		{Name: "Income Async Download", Function: TestIncomeAsyncDownload, AuthRequired: AuthTypeUSER_DATA, Category: "Income"},
 		{Name: "Order Async", Function: TestOrderAsync, AuthRequired: AuthTypeUSER_DATA, Category: "Income"},
@@ -533,7 +602,7 @@ func getTestClient(t *testing.T) *openapi.APIClient {
 	configs := getTestConfigs()
 	for _, config := range configs {
 		if config.AuthType == AuthTypeTRADE {
-			client, _ := setupClient(config)
+			client, _ := pooledClientFor(config)
 			return client
 		}
 	}
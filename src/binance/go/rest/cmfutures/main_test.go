@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -51,8 +52,21 @@ func TestMain(m *testing.M) {
 	fmt.Println("=== Binance CM Futures REST API Integration Tests ===")
 	fmt.Println("Setting up test environment...")
 	
-	// Set up any global test configuration here
-	// For example, check for required environment variables
+	// Fail fast on an unusable proxy instead of timing out every request
+	if err := configureHTTPProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+	
+	// Align request timestamps with the server clock before any signed request
+	syncRequestClock()
+	
+	// Fail fast on a misconfigured symbol override instead of failing every test
+	if err := validateConfiguredSymbols(); err != nil {
+		fmt.Printf("Invalid test configuration: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Using test symbols: %s, %s\n", getTestSymbol(), getTestSymbol2())
 	
 	// Run the tests
 	exitCode := m.Run()
@@ -4,9 +4,12 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	openapi "github.com/openxapi/binance-go/rest/cmfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/tickerstats"
 )
 
 // Default test symbols for CM Futures
@@ -433,6 +436,10 @@ func Test24hrTicker(t *testing.T) {
 						t.Fatal("Volume is nil")
 					}
 					
+					for _, problem := range tickerstats.Violations(ticker.OpenPrice, ticker.LastPrice, ticker.HighPrice, ticker.LowPrice, ticker.WeightedAvgPrice, ticker.PriceChangePercent) {
+						t.Errorf("%s 24hr ticker: %s", *ticker.Symbol, problem)
+					}
+					
 					t.Logf("24hr ticker for %s: last_price=%s, volume=%s", 
 						*ticker.Symbol, *ticker.LastPrice, *ticker.Volume)
 				})
@@ -533,6 +540,64 @@ func TestTickerBook(t *testing.T) {
 	}
 }
 
+// TestBookTickerByPair tests the ticker book endpoint queried by pair, which returns
+// every contract (perpetual and quarterlies) of that pair
+func TestBookTickerByPair(t *testing.T) {
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType == AuthTypeNONE {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "BookTickerByPair", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					pair := "BTCUSD"
+					
+					req := client.FuturesAPI.GetTickerBookTickerV1(ctx).Pair(pair)
+					resp, httpResp, err := req.Execute()
+					
+					if handleTestnetError(t, err, httpResp, "BookTickerByPair") {
+						return
+					}
+					
+					if err != nil {
+						checkAPIError(t, err, httpResp, "BookTickerByPair")
+						t.Fatalf("Ticker book by pair failed: %v", err)
+					}
+					
+					if len(resp) < 2 {
+						t.Fatalf("Expected multiple contracts for pair %s (perpetual + quarterlies), got %d", pair, len(resp))
+					}
+					
+					var symbols []string
+					for i, ticker := range resp {
+						if ticker.Symbol == nil || !strings.HasPrefix(*ticker.Symbol, pair+"_") {
+							t.Errorf("Entry %d: symbol %v does not belong to pair %s", i, ticker.Symbol, pair)
+							continue
+						}
+						symbols = append(symbols, *ticker.Symbol)
+						
+						if ticker.BidPrice == nil || ticker.AskPrice == nil {
+							t.Errorf("%s: bid or ask price is nil", *ticker.Symbol)
+							continue
+						}
+						bid, bidErr := strconv.ParseFloat(*ticker.BidPrice, 64)
+						ask, askErr := strconv.ParseFloat(*ticker.AskPrice, 64)
+						if bidErr != nil || askErr != nil {
+							t.Errorf("%s: unparseable bid=%q ask=%q", *ticker.Symbol, *ticker.BidPrice, *ticker.AskPrice)
+							continue
+						}
+						// An empty side is reported as zero on thin testnet books
+						if bid > 0 && ask > 0 && bid >= ask {
+							t.Errorf("%s: bid %s is not below ask %s", *ticker.Symbol, *ticker.BidPrice, *ticker.AskPrice)
+						}
+					}
+					
+					t.Logf("Ticker book for pair %s returned %d contracts: %s", pair, len(symbols), strings.Join(symbols, ", "))
+				})
+			})
+			break
+		}
+	}
+}
+
 // TestPremiumIndex tests the premium index endpoint
 func TestPremiumIndex(t *testing.T) {
 	configs := getTestConfigs()
@@ -601,6 +666,14 @@ func TestFundingRate(t *testing.T) {
 						t.Fatal("No funding rate data returned")
 					}
 					
+					times := make([]int64, 0, len(resp))
+					for _, rate := range resp {
+						if rate.FundingTime != nil {
+							times = append(times, *rate.FundingTime)
+						}
+					}
+					assertAscendingByTime(t, times, "fundingTime")
+					
 					firstRate := resp[0]
 					if firstRate.Symbol == nil {
 						t.Fatal("First rate has nil symbol")
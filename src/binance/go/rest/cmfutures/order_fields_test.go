@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+// decimalEqual compares two decimal strings numerically, so "0.0010" equals "0.001"
+func decimalEqual(a, b string) bool {
+	ra, okA := new(big.Rat).SetString(a)
+	rb, okB := new(big.Rat).SetString(b)
+	if !okA || !okB {
+		return false
+	}
+	return ra.Cmp(rb) == 0
+}
+
+// assertSubmittedOrderFields checks that a queried order echoes the parameters it was created with
+func assertSubmittedOrderFields(t *testing.T, side, orderType, timeInForce, price, quantity string,
+	gotSide, gotType, gotTimeInForce, gotPrice, gotOrigQty *string) {
+	t.Helper()
+
+	exact := []struct {
+		field string
+		want  string
+		got   *string
+	}{
+		{"Side", side, gotSide},
+		{"Type", orderType, gotType},
+		{"TimeInForce", timeInForce, gotTimeInForce},
+	}
+	for _, f := range exact {
+		if f.got == nil {
+			t.Fatalf("%s is nil, expected %s", f.field, f.want)
+		}
+		if *f.got != f.want {
+			t.Fatalf("%s mismatch: submitted %s, queried %s", f.field, f.want, *f.got)
+		}
+	}
+
+	decimals := []struct {
+		field string
+		want  string
+		got   *string
+	}{
+		{"Price", price, gotPrice},
+		{"OrigQty", quantity, gotOrigQty},
+	}
+	for _, f := range decimals {
+		if f.got == nil {
+			t.Fatalf("%s is nil, expected %s", f.field, f.want)
+		}
+		if !decimalEqual(f.want, *f.got) {
+			t.Fatalf("%s mismatch: submitted %s, queried %s", f.field, f.want, *f.got)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/openxapi/integration-tests/src/binance/go/proxyenv"
+)
+
+// httpProxyEnvVar names the proxy used for all REST traffic
+const httpProxyEnvVar = "BINANCE_TEST_HTTP_PROXY"
+
+// restProxy is the proxy validated from BINANCE_TEST_HTTP_PROXY at startup; nil sends requests directly
+var restProxy *url.URL
+
+// configureHTTPProxy validates BINANCE_TEST_HTTP_PROXY and checks the proxy accepts connections
+func configureHTTPProxy() error {
+	u, err := proxyenv.FromEnv(httpProxyEnvVar)
+	if err != nil {
+		return err
+	}
+	restProxy = u
+	return nil
+}
+
+// newTransport returns a client-owned clone of the default transport that routes through
+// restProxy when one is configured; the process-wide default transport is never modified
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if restProxy != nil {
+		transport.Proxy = http.ProxyURL(restProxy)
+	}
+	return transport
+}
+
+// TestHTTPProxyConfiguration tests that a client from setupClient routes its requests through restProxy
+func TestHTTPProxyConfiguration(t *testing.T) {
+	original := restProxy
+	t.Cleanup(func() { restProxy = original })
+	restProxy = &url.URL{Scheme: "socks5", Host: "127.0.0.1:1080"}
+
+	client, _ := setupClient(TestConfig{Name: "Proxy", AuthType: AuthTypeNONE})
+	transport, ok := client.GetConfig().HTTPClient.Transport.(*http.Transport)
+	if !ok || transport.Proxy == nil {
+		t.Fatal("Expected setupClient to install a transport with a proxy")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, client.GetConfig().Servers[0].URL, nil)
+	got, err := transport.Proxy(req)
+	if err != nil || got == nil || got.String() != restProxy.String() {
+		t.Errorf("Expected requests to use proxy %s, got %v (%v)", restProxy, got, err)
+	}
+}
@@ -0,0 +1,431 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	openapi "github.com/openxapi/binance-go/rest/cmfutures"
+)
+
+// capturedRequest is a snapshot of an outgoing request taken before it is sent
+type capturedRequest struct {
+	Method   string
+	URL      string
+	RawQuery string
+	Query    url.Values
+	Header   http.Header
+	Body     string
+}
+
+// inspectingTransport records every request before forwarding it to next
+type inspectingTransport struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	requests []capturedRequest
+}
+
+func (it *inspectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	captured := capturedRequest{
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		RawQuery: req.URL.RawQuery,
+		Query:    req.URL.Query(),
+		Header:   req.Header.Clone(),
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		captured.Body = string(body)
+		// Restore the body for the forwarded request
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	it.mu.Lock()
+	it.requests = append(it.requests, captured)
+	it.mu.Unlock()
+
+	return it.next.RoundTrip(req)
+}
+
+// captured returns the requests recorded so far
+func (it *inspectingTransport) captured() []capturedRequest {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return append([]capturedRequest(nil), it.requests...)
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// cannedResponse answers every request with an empty JSON object so no network is used
+var cannedResponse = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+})
+
+// TestSignedGetParamsInQuery tests that a signed GET carries timestamp, recvWindow and
+// signature in the URL query and leaves the body empty
+func TestSignedGetParamsInQuery(t *testing.T) {
+	config := TestConfig{Name: "HMAC", APIKey: "inspect-key", SecretKey: "inspect-secret", SignType: "HMAC", AuthType: AuthTypeUSER_DATA}
+	client, ctx := setupClient(config)
+
+	transport := &inspectingTransport{next: cannedResponse}
+	client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+
+	_, _, err := client.FuturesAPI.GetAccountV1(ctx).
+		RecvWindow(5000).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		t.Fatalf("GetAccountV1 against canned transport failed: %v", err)
+	}
+
+	requests := transport.captured()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 captured request, got %d", len(requests))
+	}
+	req := requests[0]
+
+	if req.Method != http.MethodGet {
+		t.Errorf("Expected GET, got %s", req.Method)
+	}
+	for _, param := range []string{"timestamp", "recvWindow", "signature"} {
+		if len(req.Query[param]) != 1 || req.Query[param][0] == "" {
+			t.Errorf("Expected %s exactly once in the query string, got %v (url=%s)", param, req.Query[param], scrubDebugOutput(req.URL))
+		}
+		if strings.Contains(req.Body, param+"=") {
+			t.Errorf("Expected %s to be absent from the body, got body %q", param, scrubDebugOutput(req.Body))
+		}
+	}
+	if req.Body != "" {
+		t.Errorf("Expected an empty body for a signed GET, got %q", scrubDebugOutput(req.Body))
+	}
+}
+
+// splitSignature separates a trailing signature parameter from an encoded parameter string
+func splitSignature(encoded string) (payload string, signature string, ok bool) {
+	idx := strings.LastIndex(encoded, "signature=")
+	if idx < 0 {
+		return encoded, "", false
+	}
+	return strings.TrimSuffix(encoded[:idx], "&"), encoded[idx+len("signature="):], true
+}
+
+// TestSignedPostParamsInBody tests that a signed POST form-encodes its parameters in the body
+// and that the HMAC signature covers them. The canned transport keeps the order from being sent.
+func TestSignedPostParamsInBody(t *testing.T) {
+	secret := "inspect-secret"
+	config := TestConfig{Name: "HMAC", APIKey: "inspect-key", SecretKey: secret, SignType: "HMAC", AuthType: AuthTypeTRADE}
+	client, ctx := setupClient(config)
+
+	transport := &inspectingTransport{next: cannedResponse}
+	client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+
+	_, _, err := client.FuturesAPI.CreateOrderV1(ctx).
+		Symbol("BTCUSD_PERP").
+		Side("BUY").
+		Type_("LIMIT").
+		TimeInForce("GTC").
+		Quantity("1").
+		Price("10000").
+		RecvWindow(5000).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		t.Fatalf("CreateOrderV1 against canned transport failed: %v", err)
+	}
+
+	requests := transport.captured()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 captured request, got %d", len(requests))
+	}
+	req := requests[0]
+
+	if req.Method != http.MethodPost {
+		t.Errorf("Expected POST, got %s", req.Method)
+	}
+	if contentType := req.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		t.Errorf("Expected form-encoded Content-Type, got %q", contentType)
+	}
+
+	form, err := url.ParseQuery(req.Body)
+	if err != nil {
+		t.Fatalf("Body is not form-encoded: %v", err)
+	}
+	for _, param := range []string{"symbol", "side", "type", "quantity", "price", "timestamp", "recvWindow"} {
+		if form.Get(param) == "" {
+			t.Errorf("Expected %s in the body, got body %q", param, scrubDebugOutput(req.Body))
+		}
+		if req.Query.Get(param) != "" {
+			t.Errorf("Expected %s to be absent from the query string, got url %s", param, scrubDebugOutput(req.URL))
+		}
+	}
+
+	// Binance signs the query string followed by the body, excluding the signature itself
+	payload, signature, inBody := splitSignature(req.Body)
+	if !inBody {
+		if req.Query.Get("signature") == "" {
+			t.Fatal("Expected a signature in the body or query string")
+		}
+		signature = req.Query.Get("signature")
+		payload = req.Body
+	}
+	signed := payload
+	if query, _, _ := splitSignature(req.RawQuery); query != "" {
+		signed = query + payload
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	if expected := hex.EncodeToString(mac.Sum(nil)); signature != expected {
+		t.Errorf("Signature does not cover the body params: got %s, want HMAC of %q", signature, signed)
+	}
+}
+
+const (
+	// encodedOrderIdList and encodedClientOrderIdList hold JSON list values whose brackets, commas,
+	// quotes and colons must be percent-encoded on the wire
+	encodedOrderIdList       = "[1,2,3]"
+	encodedClientOrderIdList = `["enc:list/1","enc:list/2"]`
+	// unknownOrderCode is returned per item when a cancel names an order that does not exist
+	unknownOrderCode = -2011
+)
+
+// rawParam returns the still-encoded value of name from an encoded parameter string
+func rawParam(encoded, name string) (string, bool) {
+	for _, pair := range strings.Split(encoded, "&") {
+		if key, value, found := strings.Cut(pair, "="); found && key == name {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// isUnreservedOrEscape reports whether c may appear unescaped in an encoded parameter value
+func isUnreservedOrEscape(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("-._~%", c) >= 0
+}
+
+// assertParamEncoded checks that name is sent exactly once, in the query string or the body,
+// percent-encoded so that it decodes back to want
+func assertParamEncoded(t *testing.T, req capturedRequest, name, want string) {
+	t.Helper()
+	raw, inQuery := rawParam(req.RawQuery, name)
+	if !inQuery {
+		var inBody bool
+		if raw, inBody = rawParam(req.Body, name); !inBody {
+			t.Errorf("Expected %s in the query string or body, got url %s body %q",
+				name, scrubDebugOutput(req.URL), scrubDebugOutput(req.Body))
+			return
+		}
+	}
+
+	for i := 0; i < len(raw); i++ {
+		if !isUnreservedOrEscape(raw[i]) {
+			t.Errorf("Expected %s to be percent-encoded, found raw %q in %q", name, raw[i], raw)
+			break
+		}
+	}
+	decoded, err := url.QueryUnescape(raw)
+	if err != nil {
+		t.Errorf("Encoded %s %q does not decode: %v", name, raw, err)
+		return
+	}
+	if decoded != want {
+		t.Errorf("Expected %s to decode to %s, got %s (sent %q)", name, want, decoded, raw)
+	}
+}
+
+// TestListParamEncoding tests that JSON list params and the symbol are percent-encoded so the
+// server decodes exactly the values the caller passed. The canned transport keeps the cancel from being sent.
+func TestListParamEncoding(t *testing.T) {
+	config := TestConfig{Name: "HMAC", APIKey: "inspect-key", SecretKey: "inspect-secret", SignType: "HMAC", AuthType: AuthTypeTRADE}
+	client, ctx := setupClient(config)
+
+	transport := &inspectingTransport{next: cannedResponse}
+	client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+
+	symbol := "BTCUSD_PERP"
+	client.FuturesAPI.DeleteBatchOrdersV1(ctx).
+		Symbol(symbol).
+		OrderIdList(encodedOrderIdList).
+		Timestamp(generateTimestamp()).
+		Execute()
+	client.FuturesAPI.DeleteBatchOrdersV1(ctx).
+		Symbol(symbol).
+		OrigClientOrderIdList(encodedClientOrderIdList).
+		Timestamp(generateTimestamp()).
+		Execute()
+
+	requests := transport.captured()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 captured requests, got %d", len(requests))
+	}
+	for _, req := range requests {
+		if req.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", req.Method)
+		}
+		assertParamEncoded(t, req, "symbol", symbol)
+	}
+	assertParamEncoded(t, requests[0], "orderIdList", encodedOrderIdList)
+	assertParamEncoded(t, requests[1], "origClientOrderIdList", encodedClientOrderIdList)
+}
+
+// TestListParamEncodingAccepted sends batch cancels for orders that do not exist and checks both
+// the outgoing encoding and that the exchange read each list as the intended ids: one
+// unknown-order result per id rather than a parameter error for the whole request
+func TestListParamEncodingAccepted(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			rateLimiter.WaitForRateLimit()
+
+			// The pooled client is shared, so the changes below go to a copy of its configuration
+			pooled, baseCtx := pooledClientFor(config)
+			client := openapi.NewAPIClient(copyConfiguration(pooled.GetConfig()))
+			ctx, cancel := context.WithTimeout(baseCtx, 30*time.Second)
+			defer cancel()
+
+			transport := &inspectingTransport{next: newTransport()}
+			client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+			symbol := getTestSymbol()
+
+			cases := []struct {
+				param string
+				value string
+				ids   int
+			}{
+				{"orderIdList", encodedOrderIdList, 3},
+				{"origClientOrderIdList", encodedClientOrderIdList, 2},
+			}
+
+			for _, tc := range cases {
+				req := client.FuturesAPI.DeleteBatchOrdersV1(ctx).
+					Symbol(symbol).
+					Timestamp(generateTimestamp())
+				if tc.param == "orderIdList" {
+					req = req.OrderIdList(tc.value)
+				} else {
+					req = req.OrigClientOrderIdList(tc.value)
+				}
+
+				before := len(transport.captured())
+				resp, httpResp, err := req.Execute()
+
+				requests := transport.captured()
+				if len(requests) != before+1 {
+					t.Fatalf("Expected 1 captured request for %s, got %d", tc.param, len(requests)-before)
+				}
+				assertParamEncoded(t, requests[before], "symbol", symbol)
+				assertParamEncoded(t, requests[before], tc.param, tc.value)
+
+				if handleTestnetError(t, err, httpResp, "ListParamEncodingAccepted") {
+					return
+				}
+				if err != nil {
+					if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok && strings.Contains(string(apiErr.Body()), tc.param) {
+						recordSDKIssue("ListParamEncodingAccepted", tc.param+" parameter rejected by the exchange")
+						t.Fatalf("Exchange rejected %s %s as sent: %s", tc.param, tc.value, string(apiErr.Body()))
+					}
+					checkAPIError(t, err, httpResp, "ListParamEncodingAccepted")
+					t.Fatalf("Batch cancel with %s failed: %v", tc.param, err)
+				}
+
+				if len(resp) != tc.ids {
+					t.Fatalf("Expected %d results for %s %s, got %d; the server split the list differently",
+						tc.ids, tc.param, tc.value, len(resp))
+				}
+				for i, item := range resp {
+					if item.APIError == nil || item.APIError.Code == nil {
+						t.Errorf("Result %d for %s should be an unknown-order error, got %+v", i+1, tc.param, item)
+						continue
+					}
+					if code := int64(*item.APIError.Code); code != unknownOrderCode {
+						t.Errorf("Result %d for %s: expected code %d, got %d", i+1, tc.param, unknownOrderCode, code)
+					}
+				}
+				t.Logf("%s %s accepted and read as %d ids", tc.param, tc.value, len(resp))
+			}
+		})
+		break
+	}
+}
+
+// customUserAgent is the User-Agent TestCustomUserAgent configures for API-usage attribution
+const customUserAgent = "integration-tests-ua/1.0 (+cmfutures)"
+
+// TestCustomUserAgent sets a User-Agent on the client configuration and checks that the outgoing
+// request carries it and that a public endpoint still accepts the request
+func TestCustomUserAgent(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			rateLimiter.WaitForRateLimit()
+
+			// The pooled client is shared, so the changes below go to a copy of its configuration
+			pooled, baseCtx := pooledClientFor(config)
+			client := openapi.NewAPIClient(copyConfiguration(pooled.GetConfig()))
+			ctx, cancel := context.WithTimeout(baseCtx, 30*time.Second)
+			defer cancel()
+
+			client.GetConfig().UserAgent = customUserAgent
+			transport := &inspectingTransport{next: newTransport()}
+			client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+
+			resp, httpResp, err := client.FuturesAPI.GetTimeV1(ctx).Execute()
+
+			requests := transport.captured()
+			if len(requests) != 1 {
+				t.Fatalf("Expected 1 captured request, got %d", len(requests))
+			}
+			if got := requests[0].Header.Values("User-Agent"); len(got) != 1 || got[0] != customUserAgent {
+				recordSDKIssue("CustomUserAgent", "configured User-Agent not sent; the SDK overrides Configuration.UserAgent")
+				t.Errorf("Expected User-Agent %q, request carried %q", customUserAgent, got)
+			}
+
+			if handleTestnetError(t, err, httpResp, "CustomUserAgent") {
+				return
+			}
+			if err != nil {
+				checkAPIError(t, err, httpResp, "CustomUserAgent")
+				t.Fatalf("Server time with custom User-Agent failed: %v", err)
+			}
+			if resp.ServerTime == nil {
+				t.Fatal("Server time response is nil")
+			}
+
+			t.Logf("Server time %d returned for a request with User-Agent %q", *resp.ServerTime, customUserAgent)
+		})
+		break
+	}
+}
@@ -1,14 +1,19 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/cmfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // Global SDK issue tracker
@@ -31,6 +36,203 @@ func getSDKIssues() []string {
 	return append([]string(nil), sdkIssues...)
 }
 
+// assertRecentServerTime fails the test if a server timestamp (ms) is zero or not close to the
+// synced request clock
+func assertRecentServerTime(t *testing.T, ms int64, field string) {
+	t.Helper()
+	if err := timestamp.CheckRecent(ms); err != nil {
+		t.Fatalf("%s %v", field, err)
+	}
+}
+
+// firstOutOfOrder returns the index of the first element smaller than its predecessor,
+// or -1 when times is non-decreasing. Equal adjacent values are allowed.
+func firstOutOfOrder(times []int64) int {
+	for i := 1; i < len(times); i++ {
+		if times[i] < times[i-1] {
+			return i
+		}
+	}
+	return -1
+}
+
+// assertEmptySlice fails the test if a list endpoint's result is nil rather than a typed empty
+// slice, which points at a null body or a decode failure instead of an account with no entries
+func assertEmptySlice[T any](t *testing.T, resp []T, name string) {
+	t.Helper()
+	if resp == nil {
+		t.Errorf("%s returned a nil %T instead of an empty slice; the response was not decoded as an array", name, resp)
+	}
+}
+
+// assertAscendingByTime fails the test if times, taken from field of each list entry,
+// are not in the time-ascending order the endpoint documents
+func assertAscendingByTime(t *testing.T, times []int64, field string) {
+	t.Helper()
+	if i := firstOutOfOrder(times); i >= 0 {
+		t.Errorf("%s is not ascending: entry %d has %d after %d", field, i, times[i], times[i-1])
+	}
+}
+
+// maxPollInterval caps the backoff between eventually polls
+const maxPollInterval = 5 * time.Second
+
+// errEventuallyTimeout is returned when a polled condition never became true
+var errEventuallyTimeout = errors.New("condition not met before timeout")
+
+// eventually polls cond until it reports true, returns an error or timeout elapses, doubling the
+// wait between polls up to maxPollInterval. The last poll happens at the deadline, and polling
+// stops early if the test's context is canceled.
+func eventually(t testing.TB, timeout, interval time.Duration, cond func() (bool, error)) error {
+	t.Helper()
+	return eventuallyCtx(t.Context(), timeout, interval, cond)
+}
+
+// eventuallyCtx is eventually with an explicit parent context
+func eventuallyCtx(ctx context.Context, timeout, interval time.Duration, cond func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := cond()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("%w after %v", errEventuallyTimeout, timeout)
+		}
+
+		wait := interval
+		if wait > remaining {
+			wait = remaining
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxPollInterval {
+			interval = maxPollInterval
+		}
+	}
+}
+
+// validateSymbolStatus checks that symbol is listed and its contract is currently TRADING.
+// statuses maps each exchangeInfo symbol to its contractStatus.
+func validateSymbolStatus(envVar, symbol string, statuses map[string]string) error {
+	status, ok := statuses[symbol]
+	if !ok {
+		return fmt.Errorf("%s=%q is not listed in exchangeInfo; unset it or choose an existing COIN-M symbol", envVar, symbol)
+	}
+	if status != "TRADING" {
+		return fmt.Errorf("%s=%q has contract status %s; choose a symbol with status TRADING", envVar, symbol, status)
+	}
+	return nil
+}
+
+// validateConfiguredSymbols verifies user-supplied symbol overrides against exchangeInfo.
+// The hardcoded defaults are not checked.
+func validateConfiguredSymbols() error {
+	overrides := map[string]string{}
+	for _, envVar := range []string{"BINANCE_TEST_CMFUTURES_SYMBOL", "BINANCE_TEST_CMFUTURES_SYMBOL2"} {
+		if symbol := os.Getenv(envVar); symbol != "" {
+			overrides[envVar] = symbol
+		}
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	statuses, err := symbolStatuses()
+	if err != nil {
+		// The exchange may be unreachable; let the tests themselves report that
+		fmt.Printf("Warning: could not validate symbol overrides: %v\n", err)
+		return nil
+	}
+
+	for _, envVar := range []string{"BINANCE_TEST_CMFUTURES_SYMBOL", "BINANCE_TEST_CMFUTURES_SYMBOL2"} {
+		if symbol, ok := overrides[envVar]; ok {
+			if err := validateSymbolStatus(envVar, symbol, statuses); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchSymbolStatuses maps each exchangeInfo symbol to its contractStatus using a public client on the configured server
+func fetchSymbolStatuses(ctx context.Context) (map[string]string, error) {
+	publicClient, _ := setupClient(TestConfig{Name: "Symbol Status", AuthType: AuthTypeNONE})
+
+	resp, _, err := publicClient.FuturesAPI.GetExchangeInfoV1(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string, len(resp.Symbols))
+	for _, s := range resp.Symbols {
+		if s.Symbol != nil && s.ContractStatus != nil {
+			statuses[*s.Symbol] = *s.ContractStatus
+		}
+	}
+	return statuses, nil
+}
+
+// Symbol statuses are fetched once per run and shared by every status check
+var (
+	symbolStatusesOnce sync.Once
+	symbolStatusesMap  map[string]string
+	symbolStatusesErr  error
+)
+
+// symbolStatuses returns the exchangeInfo statuses, fetching them on first use. A failed fetch
+// is cached too, so an unreachable exchange costs one timeout rather than one per test.
+func symbolStatuses() (map[string]string, error) {
+	symbolStatusesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		symbolStatusesMap, symbolStatusesErr = fetchSymbolStatuses(ctx)
+	})
+	return symbolStatusesMap, symbolStatusesErr
+}
+
+// nonTradingReason explains why symbol cannot take orders, or returns "" when it is TRADING.
+// statuses maps each exchangeInfo symbol to its contractStatus.
+func nonTradingReason(symbol string, statuses map[string]string) string {
+	status, ok := statuses[symbol]
+	if !ok {
+		return fmt.Sprintf("%s is not listed in exchangeInfo", symbol)
+	}
+	if status != "TRADING" {
+		return fmt.Sprintf("%s has contract status %s, not TRADING; skipping as a market-state issue, not an SDK bug", symbol, status)
+	}
+	return ""
+}
+
+// requireTrading skips the test when symbol's contract is not currently TRADING
+// (e.g. PENDING_TRADING, DELIVERING, SETTLING). If exchangeInfo cannot be fetched
+// the test continues and reports its own failure.
+func requireTrading(t *testing.T, symbol string) {
+	t.Helper()
+
+	statuses, err := symbolStatuses()
+	if err != nil {
+		t.Logf("Warning: could not check contract status of %s: %v", symbol, err)
+		return
+	}
+	if reason := nonTradingReason(symbol, statuses); reason != "" {
+		t.Skip(reason)
+	}
+}
+
 // handleTestnetError checks if error is due to testnet limitations and skips test if so
 // Returns true if test should be skipped, false if test should continue
 func handleTestnetError(t *testing.T, err error, httpResp *http.Response, testName string) bool {
@@ -137,6 +339,23 @@ func handleTestnetError(t *testing.T, err error, httpResp *http.Response, testNa
 	return false
 }
 
+// apiErrorCode extracts the Binance error code from an SDK error, reporting false if there is none
+func apiErrorCode(err error) (int64, bool) {
+	var model interface{}
+	switch apiErr := err.(type) {
+	case *openapi.GenericOpenAPIError:
+		model = apiErr.Model()
+	case openapi.GenericOpenAPIError:
+		model = apiErr.Model()
+	default:
+		return 0, false
+	}
+	if apiError, ok := model.(*openapi.APIError); ok && apiError.Code != nil {
+		return int64(*apiError.Code), true
+	}
+	return 0, false
+}
+
 // logAPIError safely logs API error details for debugging
 func logAPIError(t *testing.T, err error) {
 	if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok {
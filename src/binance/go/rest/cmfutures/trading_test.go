@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -25,6 +26,7 @@ func TestCreateOrder(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "CreateOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// Get current price and set a much higher price to avoid fill
 					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
@@ -69,6 +71,11 @@ func TestCreateOrder(t *testing.T) {
 						t.Fatal("Status is nil")
 					}
 					
+					if resp.UpdateTime == nil {
+						t.Fatal("UpdateTime is nil")
+					}
+					assertRecentServerTime(t, *resp.UpdateTime, "CreateOrder.updateTime")
+					
 					orderId := *resp.OrderId
 					t.Logf("Created order: id=%d, symbol=%s, status=%s", orderId, *resp.Symbol, *resp.Status)
 					
@@ -98,6 +105,7 @@ func TestGetOrder(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "GetOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First create an order to query
 					if os.Getenv("BINANCE_TEST_CMFUTURES_TRADING") == "true" {
@@ -122,6 +130,15 @@ func TestGetOrder(t *testing.T) {
 						if createErr == nil && createResp.OrderId != nil {
 							orderId := *createResp.OrderId
 							
+							// Clean up even if a field assertion fails
+							defer func() {
+								cancelReq := client.FuturesAPI.DeleteOrderV1(ctx).
+									Symbol(symbol).
+									OrderId(orderId).
+									Timestamp(generateTimestamp())
+								cancelReq.Execute()
+							}()
+							
 							// Query the order
 							time.Sleep(100 * time.Millisecond)
 							req := client.FuturesAPI.GetOrderV1(ctx).
@@ -152,14 +169,11 @@ func TestGetOrder(t *testing.T) {
 								t.Fatal("Status is nil")
 							}
 							
-							t.Logf("Queried order: id=%d, symbol=%s, status=%s", *resp.OrderId, *resp.Symbol, *resp.Status)
+							// The order was created here, so every submitted parameter must round-trip
+							assertSubmittedOrderFields(t, "BUY", "LIMIT", "GTC", highPrice, "1",
+								resp.Side, resp.Type, resp.TimeInForce, resp.Price, resp.OrigQty)
 							
-							// Clean up
-							cancelReq := client.FuturesAPI.DeleteOrderV1(ctx).
-								Symbol(symbol).
-								OrderId(orderId).
-								Timestamp(generateTimestamp())
-							cancelReq.Execute()
+							t.Logf("Queried order: id=%d, symbol=%s, status=%s", *resp.OrderId, *resp.Symbol, *resp.Status)
 							
 							return
 						}
@@ -234,6 +248,7 @@ func TestCancelOrder(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "CancelOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First create an order to cancel
 					// Get current price and set higher price to avoid fill
@@ -323,6 +338,7 @@ func TestUpdateOrder(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "UpdateOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First create a limit order to update
 					// Get current price and set higher price to avoid fill
@@ -431,6 +447,7 @@ func TestAllOrders(t *testing.T) {
 					}
 					
 					t.Logf("All orders for %s: count=%d", symbol, len(resp))
+					assertEmptySlice(t, resp, "allOrders")
 					
 					// Check structure of first order if any exist
 					if len(resp) > 0 {
@@ -465,6 +482,7 @@ func TestOpenOrder(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "OpenOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First get open orders to find one to query
 					openOrdersReq := client.FuturesAPI.GetOpenOrdersV1(ctx).
@@ -627,12 +645,19 @@ func TestOpenOrders(t *testing.T) {
 	}
 }
 
-// TestCancelAllOrders tests canceling all open orders
+// cancelAllOrdersDoneMsg is the msg returned when DeleteAllOpenOrdersV1 succeeds
+const cancelAllOrdersDoneMsg = "The operation of cancel all open order is done."
+
+// TestCancelAllOrders tests that canceling all open orders clears the symbol's order book
 func TestCancelAllOrders(t *testing.T) {
 	// Skip if cancel operations are not enabled
 	if os.Getenv("BINANCE_TEST_CMFUTURES_CANCEL_ORDERS") != "true" {
 		t.Skip("Cancel operations disabled. Set BINANCE_TEST_CMFUTURES_CANCEL_ORDERS=true to enable")
 	}
+	// The orders to cancel are created here, so trading must be enabled too
+	if os.Getenv("BINANCE_TEST_CMFUTURES_TRADING") != "true" {
+		t.Skip("Trading operations disabled. Set BINANCE_TEST_CMFUTURES_TRADING=true to enable")
+	}
 
 	configs := getTestConfigs()
 	for _, config := range configs {
@@ -640,31 +665,71 @@ func TestCancelAllOrders(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "CancelAllOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
-					// First create some orders to cancel
-					if os.Getenv("BINANCE_TEST_CMFUTURES_TRADING") == "true" {
-						// Get current price and set higher prices to avoid fill
-						currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
-						if priceErr != nil {
-							t.Skipf("Failed to get current price for order creation: %v", priceErr)
-							return
-						}
-						
-						// Create a couple of orders
-						for i := 0; i < 2; i++ {
-							price := fmt.Sprintf("%.1f", currentPrice*1.02+float64(i)*0.001)
-							createReq := client.FuturesAPI.CreateOrderV1(ctx).
+					// Create several resting orders so the cancel has something to clear
+					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
+					if priceErr != nil {
+						t.Fatalf("Failed to get current price for order creation: %v", priceErr)
+					}
+					
+					tickSize, minPrice, tickErr := getTickSizeForSymbol(client, ctx, symbol)
+					if tickErr != nil {
+						t.Fatalf("Failed to get tick size for %s: %v", symbol, tickErr)
+					}
+					
+					// Buys 2% below market, one tick apart, rest on the book without filling
+					const orderCount = 3
+					orderIds := make(map[int64]bool, orderCount)
+					defer func() {
+						// Cancel anything a failed step leaves open; orders already cleared return -2011
+						for orderId := range orderIds {
+							client.FuturesAPI.DeleteOrderV1(ctx).
 								Symbol(symbol).
-								Side("BUY").
-								Type_("LIMIT").
-								TimeInForce("GTC").
-								Quantity("1").
-								Price(price).
-								Timestamp(generateTimestamp())
-							
-							createReq.Execute()
-							time.Sleep(100 * time.Millisecond)
+								OrderId(orderId).
+								Timestamp(generateTimestamp()).
+								Execute()
 						}
+					}()
+					for i := 0; i < orderCount; i++ {
+						price := fmt.Sprintf("%.8f", roundToTickSize(currentPrice*0.98-float64(i)*tickSize, tickSize, minPrice))
+						created, createHTTPResp, createErr := client.FuturesAPI.CreateOrderV1(ctx).
+							Symbol(symbol).
+							Side("BUY").
+							Type_("LIMIT").
+							TimeInForce("GTC").
+							Quantity("1").
+							Price(price).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if createErr != nil {
+							checkAPIError(t, createErr, createHTTPResp, "CancelAllOrders")
+							t.Fatalf("Failed to create order %d of %d: %v", i+1, orderCount, createErr)
+						}
+						if created.OrderId == nil {
+							t.Fatalf("Order %d of %d has no orderId", i+1, orderCount)
+						}
+						orderIds[*created.OrderId] = true
+						time.Sleep(100 * time.Millisecond)
+					}
+					
+					// Every order must be resting, otherwise the cancel below proves nothing
+					openBefore, openHTTPResp, openErr := client.FuturesAPI.GetOpenOrdersV1(ctx).
+						Symbol(symbol).
+						Timestamp(generateTimestamp()).
+						Execute()
+					if openErr != nil {
+						checkAPIError(t, openErr, openHTTPResp, "CancelAllOrders")
+						t.Fatalf("Open orders failed: %v", openErr)
+					}
+					resting := 0
+					for _, order := range openBefore {
+						if order.OrderId != nil && orderIds[*order.OrderId] {
+							resting++
+						}
+					}
+					if resting != orderCount {
+						t.Fatalf("Expected %d open orders before cancel all, found %d of them open", orderCount, resting)
 					}
 					
 					// Cancel all open orders
@@ -686,8 +751,34 @@ func TestCancelAllOrders(t *testing.T) {
 					if resp.Code == nil {
 						t.Fatal("Code is nil")
 					}
+					if *resp.Code != 200 {
+						t.Errorf("Expected code 200, got %d", *resp.Code)
+					}
+					if resp.Msg == nil || *resp.Msg != cancelAllOrdersDoneMsg {
+						t.Errorf("Expected msg %q, got %v", cancelAllOrdersDoneMsg, resp.Msg)
+					}
 					
-					t.Logf("Canceled all orders for %s: code=%d", symbol, *resp.Code)
+					// Cancellation is processed asynchronously, so poll briefly until the book is clear
+					var remaining int
+					pollErr := eventually(t, 5*time.Second, 500*time.Millisecond, func() (bool, error) {
+						openOrders, openHTTPResp, openErr := client.FuturesAPI.GetOpenOrdersV1(ctx).
+							Symbol(symbol).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if openErr != nil {
+							checkAPIError(t, openErr, openHTTPResp, "CancelAllOrders")
+							return false, openErr
+						}
+						remaining = len(openOrders)
+						return remaining == 0, nil
+					})
+					if errors.Is(pollErr, errEventuallyTimeout) {
+						t.Errorf("Expected no open orders for %s after cancel all, %d remain", symbol, remaining)
+					} else if pollErr != nil {
+						t.Fatalf("Open orders failed: %v", pollErr)
+					}
+					
+					t.Logf("Canceled all orders for %s: code=%d, %d orders cleared", symbol, *resp.Code, orderCount)
 				})
 			})
 			break
@@ -707,13 +798,8 @@ func TestBatchOrders(t *testing.T) {
 		if config.AuthType == AuthTypeTRADE {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "BatchOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-					// Enable debug logging to see the actual HTTP request
-					client.GetConfig().Debug = true
-					defer func() {
-						client.GetConfig().Debug = false // Restore original state
-					}()
-					
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// Get current price and set higher prices to avoid fill
 					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
@@ -731,40 +817,39 @@ func TestBatchOrders(t *testing.T) {
 					clientOrderId1 := fmt.Sprintf("test_batch_1_%d", timestamp)
 					clientOrderId2 := fmt.Sprintf("test_batch_2_%d", timestamp)
 					
-					// Create batch orders as slice of maps (to be marshaled to JSON)
-					batchOrders := []map[string]interface{}{
+					batchOrders := []BatchOrderSpec{
 						{
-							"symbol":           symbol,
-							"side":            "BUY",
-							"type":            "LIMIT",
-							"quantity":        "1",
-							"price":           highPrice1,
-							"timeInForce":     "GTC",
-							"newClientOrderId": clientOrderId1,
+							Symbol:           symbol,
+							Side:             "BUY",
+							Type:             "LIMIT",
+							Quantity:         "1",
+							Price:            highPrice1,
+							TimeInForce:      "GTC",
+							NewClientOrderId: clientOrderId1,
 						},
 						{
-							"symbol":           symbol,
-							"side":            "BUY", 
-							"type":            "LIMIT",
-							"quantity":        "1",
-							"price":           highPrice2,
-							"timeInForce":     "GTC",
-							"newClientOrderId": clientOrderId2,
+							Symbol:           symbol,
+							Side:             "BUY",
+							Type:             "LIMIT",
+							Quantity:         "1",
+							Price:            highPrice2,
+							TimeInForce:      "GTC",
+							NewClientOrderId: clientOrderId2,
 						},
 					}
 					
-					// Marshal to JSON string as required by the fixed SDK
-					batchOrdersJSON, jsonErr := json.Marshal(batchOrders)
-					if jsonErr != nil {
-						t.Fatalf("Failed to marshal batch orders to JSON: %v", jsonErr)
+					batchOrdersJSON, payloadErr := buildBatchPayload(batchOrders)
+					if payloadErr != nil {
+						t.Fatalf("Invalid batch orders: %v", payloadErr)
 					}
 					
 					// Debug: log the batch orders structure
-					t.Logf("Batch orders JSON: %s", string(batchOrdersJSON))
+					t.Logf("Batch orders JSON: %s", batchOrdersJSON)
 					t.Logf("Number of orders in batch: %d", len(batchOrders))
 					
-					req := client.FuturesAPI.CreateBatchOrdersV1(ctx).
-						BatchOrders(string(batchOrdersJSON)).
+					// Only the batch request is dumped to this sub-test log, with credentials scrubbed
+					req := debugClient(t, client).FuturesAPI.CreateBatchOrdersV1(ctx).
+						BatchOrders(batchOrdersJSON).
 						Timestamp(timestamp)
 					
 					// Debug: Try to capture and log the request body
@@ -799,6 +884,12 @@ func TestBatchOrders(t *testing.T) {
 					
 					t.Logf("Batch orders created: count=%d", len(resp))
 					
+					for i, order := range resp {
+						if item := order.CmfuturesCreateBatchOrdersV1RespItem; item != nil && item.UpdateTime != nil {
+							assertRecentServerTime(t, *item.UpdateTime, fmt.Sprintf("BatchOrders[%d].updateTime", i))
+						}
+					}
+					
 					// Clean up: cancel the created orders
 					time.Sleep(100 * time.Millisecond)
 					for _, order := range resp {
@@ -831,6 +922,7 @@ func TestBatchUpdateOrders(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "BatchUpdateOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First create some orders to update
 					// Get current price and set higher prices to avoid fill
@@ -863,31 +955,28 @@ func TestBatchUpdateOrders(t *testing.T) {
 						return
 					}
 					
-					// Create batch updates as slice of maps (to be marshaled to JSON)
-					var batchUpdates []map[string]interface{}
+					var batchUpdates []BatchOrderSpec
 					for i, orderId := range orderIds {
 						price := fmt.Sprintf("%.1f", 1010.0+float64(i))
-						update := map[string]interface{}{
-							"symbol":    symbol,
-							"side":      "BUY",
-							"orderId":   orderId,
-							"quantity":  "1",
-							"price":     price,
-							"timestamp": generateTimestamp(),
-						}
-						batchUpdates = append(batchUpdates, update)
+						batchUpdates = append(batchUpdates, BatchOrderSpec{
+							Symbol:    symbol,
+							Side:      "BUY",
+							OrderId:   orderId,
+							Quantity:  "1",
+							Price:     price,
+							Timestamp: generateTimestamp(),
+						})
 					}
 					
-					// Marshal to JSON string as required by the fixed SDK
-					batchUpdatesJSON, jsonErr := json.Marshal(batchUpdates)
-					if jsonErr != nil {
-						t.Fatalf("Failed to marshal batch updates to JSON: %v", jsonErr)
+					batchUpdatesJSON, payloadErr := buildBatchPayload(batchUpdates)
+					if payloadErr != nil {
+						t.Fatalf("Invalid batch updates: %v", payloadErr)
 					}
 					
-					t.Logf("Batch updates JSON: %s", string(batchUpdatesJSON))
+					t.Logf("Batch updates JSON: %s", batchUpdatesJSON)
 					
 					req := client.FuturesAPI.UpdateBatchOrdersV1(ctx).
-						BatchOrders(string(batchUpdatesJSON)).
+						BatchOrders(batchUpdatesJSON).
 						Timestamp(generateTimestamp())
 					
 					resp, httpResp, err := req.Execute()
@@ -943,6 +1032,7 @@ func TestBatchCancelOrders(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "BatchCancelOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First create some orders to cancel
 					// Get current price and set higher prices to avoid fill
@@ -1081,6 +1171,133 @@ func TestBatchCancelOrders(t *testing.T) {
 	}
 }
 
+// TestBatchCancelOrderIdList pins the orderIdList format for DeleteBatchOrdersV1.
+// Unlike TestBatchCancelOrders it has no origClientOrderIdList fallback, so it fails
+// until the SDK serializes orderIdList in a form the exchange accepts.
+func TestBatchCancelOrderIdList(t *testing.T) {
+	// Skip if batch operations are not enabled
+	if os.Getenv("BINANCE_TEST_CMFUTURES_BATCH_ORDERS") != "true" {
+		t.Skip("Batch operations disabled. Set BINANCE_TEST_CMFUTURES_BATCH_ORDERS=true to enable")
+	}
+
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType == AuthTypeTRADE {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "BatchCancelOrderIdList", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					symbol := getTestSymbol()
+					requireTrading(t, symbol)
+					
+					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
+					if priceErr != nil {
+						t.Fatalf("Failed to get current price for order creation: %v", priceErr)
+					}
+					
+					var orderIds []int64
+					
+					// Cancel anything the batch request leaves behind, including after a partial setup
+					defer func() {
+						for _, orderId := range orderIds {
+							client.FuturesAPI.DeleteOrderV1(ctx).
+								Symbol(symbol).
+								OrderId(orderId).
+								Timestamp(generateTimestamp()).
+								Execute()
+						}
+					}()
+					
+					// Resting BUY orders below market so neither fills before the cancel
+					for i := 0; i < 2; i++ {
+						priceStr := fmt.Sprintf("%.1f", currentPrice*(0.95-float64(i)*0.01))
+						
+						createResp, createHttpResp, createErr := client.FuturesAPI.CreateOrderV1(ctx).
+							Symbol(symbol).
+							Side("BUY").
+							Type_("LIMIT").
+							TimeInForce("GTC").
+							Quantity("1").
+							Price(priceStr).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if handleTestnetError(t, createErr, createHttpResp, "BatchCancelOrderIdList") {
+							return
+						}
+						if createErr != nil || createResp.OrderId == nil {
+							t.Fatalf("Failed to create order %d for batch cancel: %v", i+1, createErr)
+						}
+						orderIds = append(orderIds, *createResp.OrderId)
+						time.Sleep(100 * time.Millisecond)
+					}
+					
+					orderIdListJSON, jsonErr := json.Marshal(orderIds)
+					if jsonErr != nil {
+						t.Fatalf("Failed to marshal order IDs to JSON: %v", jsonErr)
+					}
+					t.Logf("OrderIdList: %s", string(orderIdListJSON))
+					
+					resp, httpResp, err := client.FuturesAPI.DeleteBatchOrdersV1(ctx).
+						Symbol(symbol).
+						OrderIdList(string(orderIdListJSON)).
+						Timestamp(generateTimestamp()).
+						Execute()
+					
+					if handleTestnetError(t, err, httpResp, "BatchCancelOrderIdList") {
+						return
+					}
+					
+					if err != nil {
+						if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok {
+							body := string(apiErr.Body())
+							if strings.Contains(body, "orderIdList") {
+								recordSDKIssue("BatchCancelOrderIdList", "orderIdList parameter rejected by the exchange")
+								t.Fatalf("SDK orderIdList serialization is rejected by the exchange (sent %s): %s. "+
+									"TestBatchCancelOrders only passes via its origClientOrderIdList fallback until this is fixed",
+									string(orderIdListJSON), body)
+							}
+						}
+						checkAPIError(t, err, httpResp, "BatchCancelOrderIdList")
+						t.Fatalf("Batch cancel with orderIdList failed: %v", err)
+					}
+					
+					if len(resp) != len(orderIds) {
+						t.Fatalf("Expected %d results, got %d", len(orderIds), len(resp))
+					}
+					
+					requested := make(map[int64]bool, len(orderIds))
+					for _, orderId := range orderIds {
+						requested[orderId] = true
+					}
+					for i, order := range resp {
+						if order.APIError != nil {
+							var code int64
+							var msg string
+							if order.APIError.Code != nil {
+								code = int64(*order.APIError.Code)
+							}
+							if order.APIError.Msg != nil {
+								msg = *order.APIError.Msg
+							}
+							t.Fatalf("Order %d cancel failed: code=%d, msg=%s", i+1, code, msg)
+						}
+						item := order.CmfuturesDeleteBatchOrdersV1RespItem
+						if item == nil || item.OrderId == nil {
+							t.Fatalf("Result %d has no canceled order", i+1)
+						}
+						if !requested[*item.OrderId] {
+							t.Fatalf("Result %d canceled unexpected order %d", i+1, *item.OrderId)
+						}
+						if item.Status == nil || *item.Status != "CANCELED" {
+							t.Fatalf("Order %d status should be CANCELED, got %v", *item.OrderId, item.Status)
+						}
+						t.Logf("Order canceled via orderIdList: id=%d", *item.OrderId)
+					}
+				})
+			})
+			break
+		}
+	}
+}
+
 // TestCountdownCancelAll tests the countdown cancel all feature
 func TestCountdownCancelAll(t *testing.T) {
 	// Skip if cancel operations are not enabled
@@ -1140,6 +1357,145 @@ func TestCountdownCancelAll(t *testing.T) {
 	}
 }
 
+// TestCountdownCancelAllFires verifies that open orders are actually canceled when the countdown expires
+func TestCountdownCancelAllFires(t *testing.T) {
+	// Long-running test: waits for the countdown timer to fire
+	if os.Getenv("BINANCE_TEST_LONG") != "true" {
+		t.Skip("Long-running tests disabled. Set BINANCE_TEST_LONG=true to enable")
+	}
+	if os.Getenv("BINANCE_TEST_CMFUTURES_CANCEL_ORDERS") != "true" {
+		t.Skip("Cancel operations disabled. Set BINANCE_TEST_CMFUTURES_CANCEL_ORDERS=true to enable")
+	}
+
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType == AuthTypeTRADE {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "CountdownCancelAllFires", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					symbol := getTestSymbol()
+					requireTrading(t, symbol)
+					countdown := int64(5000) // 5 seconds
+
+					// Wait past expiry, staying well inside testEndpoint's 30s request timeout
+					waitTime := time.Duration(countdown)*time.Millisecond + 5*time.Second
+
+					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
+					if priceErr != nil {
+						t.Fatalf("Failed to get current price: %v", priceErr)
+					}
+
+					// Place a BUY limit order below market so it stays open until the countdown fires
+					lowPrice := fmt.Sprintf("%.1f", currentPrice*0.98)
+					createReq := client.FuturesAPI.CreateOrderV1(ctx).
+						Symbol(symbol).
+						Side("BUY").
+						Type_("LIMIT").
+						TimeInForce("GTC").
+						Quantity("1").
+						Price(lowPrice).
+						Timestamp(generateTimestamp())
+
+					createResp, createHttpResp, createErr := createReq.Execute()
+					if handleTestnetError(t, createErr, createHttpResp, "CountdownCancelAllFires-CreateOrder") {
+						return
+					}
+					if createErr != nil {
+						checkAPIError(t, createErr, createHttpResp, "CountdownCancelAllFires-CreateOrder")
+						t.Fatalf("Failed to create order for countdown test: %v", createErr)
+					}
+					if createResp.OrderId == nil {
+						t.Fatal("Created order has nil OrderId")
+					}
+
+					orderId := *createResp.OrderId
+					t.Logf("Created order: id=%d, price=%s", orderId, lowPrice)
+
+					// Always clear the countdown and any residual orders, even on failure
+					defer func() {
+						client.FuturesAPI.CreateCountdownCancelAllV1(ctx).
+							Symbol(symbol).
+							CountdownTime(0).
+							Timestamp(generateTimestamp()).
+							Execute()
+
+						_, _, cleanupErr := client.FuturesAPI.DeleteAllOpenOrdersV1(ctx).
+							Symbol(symbol).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if cleanupErr != nil {
+							t.Logf("Warning: Failed to clean up open orders for %s: %v", symbol, cleanupErr)
+						}
+					}()
+
+					// Arm the countdown
+					time.Sleep(100 * time.Millisecond)
+					req := client.FuturesAPI.CreateCountdownCancelAllV1(ctx).
+						Symbol(symbol).
+						CountdownTime(countdown).
+						Timestamp(generateTimestamp())
+
+					resp, httpResp, err := req.Execute()
+
+					if handleTestnetError(t, err, httpResp, "CountdownCancelAllFires") {
+						return
+					}
+
+					if err != nil {
+						checkAPIError(t, err, httpResp, "CountdownCancelAllFires")
+						t.Fatalf("Countdown cancel all failed: %v", err)
+					}
+
+					if resp.CountdownTime == nil {
+						t.Fatal("CountdownTime is nil")
+					}
+
+					t.Logf("Countdown armed: countdown=%s ms, waiting up to %v for it to fire", *resp.CountdownTime, waitTime)
+
+					// The order should become canceled (or already purged from the order store)
+					var lastStatus string
+					pollErr := eventually(t, waitTime, time.Second, func() (bool, error) {
+						queryResp, queryHttpResp, queryErr := client.FuturesAPI.GetOrderV1(ctx).
+							Symbol(symbol).
+							OrderId(orderId).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if queryErr != nil {
+							if apiErr, ok := queryErr.(*openapi.GenericOpenAPIError); ok {
+								body := string(apiErr.Body())
+								if strings.Contains(body, "Unknown order sent") || strings.Contains(body, "Order does not exist") {
+									lastStatus = "UNKNOWN"
+									return true, nil
+								}
+							}
+							checkAPIError(t, queryErr, queryHttpResp, "CountdownCancelAllFires-GetOrder")
+							return false, queryErr
+						}
+						if queryResp.Status == nil {
+							return false, fmt.Errorf("status is nil")
+						}
+						lastStatus = *queryResp.Status
+						return lastStatus == "CANCELED", nil
+					})
+
+					if errors.Is(pollErr, errEventuallyTimeout) {
+						t.Fatalf("Expected order %d to be CANCELED after countdown, got %s", orderId, lastStatus)
+					}
+					if pollErr != nil {
+						t.Fatalf("Failed to query order after countdown: %v", pollErr)
+					}
+
+					if lastStatus == "UNKNOWN" {
+						t.Logf("Order %d is unknown after countdown - treated as auto-canceled", orderId)
+						return
+					}
+					t.Logf("Order %d was auto-canceled by countdown: status=%s", orderId, lastStatus)
+				})
+			})
+			break
+		}
+	}
+}
+
 // TestOrderAmendment tests getting order modification history
 func TestOrderAmendment(t *testing.T) {
 	configs := getTestConfigs()
@@ -1148,6 +1504,7 @@ func TestOrderAmendment(t *testing.T) {
 			t.Run(config.Name, func(t *testing.T) {
 				testEndpoint(t, config, "OrderAmendment", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
 					symbol := getTestSymbol()
+					requireTrading(t, symbol)
 					
 					// First, create a test order to get an orderId
 					price, err := getCurrentPrice(client, ctx, symbol)
@@ -1183,6 +1540,44 @@ func TestOrderAmendment(t *testing.T) {
 					orderId := *createResp.OrderId
 					t.Logf("Created test order with ID: %d", orderId)
 					
+					// Clean up: Cancel the test order
+					defer func() {
+						cancelReq := client.FuturesAPI.DeleteOrderV1(ctx).
+							Symbol(symbol).
+							OrderId(orderId).
+							Timestamp(generateTimestamp())
+						
+						_, _, cancelErr := cancelReq.Execute()
+						if cancelErr != nil {
+							t.Logf("Warning: Failed to cancel test order %d: %v", orderId, cancelErr)
+						} else {
+							t.Logf("Successfully cancelled test order %d", orderId)
+						}
+					}()
+					
+					// Amend the price so the order has at least one amendment record
+					newPriceStr := fmt.Sprintf("%.1f", orderPrice*1.01)
+					updateReq := client.FuturesAPI.UpdateOrderV1(ctx).
+						Symbol(symbol).
+						OrderId(orderId).
+						Side("BUY").
+						Quantity("1").
+						Price(newPriceStr).
+						Timestamp(generateTimestamp())
+					
+					_, updateHttpResp, updateErr := updateReq.Execute()
+					if handleTestnetError(t, updateErr, updateHttpResp, "OrderAmendment-UpdateOrder") {
+						return
+					}
+					
+					if updateErr != nil {
+						checkAPIError(t, updateErr, updateHttpResp, "OrderAmendment-UpdateOrder")
+						t.Fatalf("Failed to amend test order price from %s to %s: %v", orderPriceStr, newPriceStr, updateErr)
+					}
+					
+					// Give the amendment history a moment to record the change
+					time.Sleep(500 * time.Millisecond)
+					
 					// Now query the order amendment history for this order
 					req := client.FuturesAPI.GetOrderAmendmentV1(ctx).
 						Symbol(symbol).
@@ -1202,32 +1597,193 @@ func TestOrderAmendment(t *testing.T) {
 					
 					t.Logf("Order amendments for order %d: count=%d", orderId, len(resp))
 					
-					// Check structure of first amendment if any exist
-					if len(resp) > 0 {
-						firstAmendment := resp[0]
-						if firstAmendment.Symbol == nil {
-							t.Fatal("First amendment has nil Symbol")
+					if len(resp) == 0 {
+						t.Fatalf("Expected at least one amendment record after changing price to %s", newPriceStr)
+					}
+					
+					found := false
+					for _, amendment := range resp {
+						if amendment.Symbol == nil {
+							t.Fatal("Amendment has nil Symbol")
 						}
 						
-						if firstAmendment.OrderId == nil {
-							t.Fatal("First amendment has nil OrderId")
+						if amendment.OrderId == nil || *amendment.OrderId != orderId {
+							t.Fatalf("Amendment belongs to order %v, expected %d", amendment.OrderId, orderId)
 						}
 						
-						t.Logf("First amendment: symbol=%s, orderId=%d", 
-							*firstAmendment.Symbol, *firstAmendment.OrderId)
+						if amendment.Amendment == nil || amendment.Amendment.Price == nil {
+							continue
+						}
+						
+						priceChange := amendment.Amendment.Price
+						if priceChange.Before == nil || priceChange.After == nil {
+							t.Fatal("Price amendment is missing its before/after values")
+						}
+						
+						t.Logf("Amendment: symbol=%s, orderId=%d, price %s -> %s",
+							*amendment.Symbol, *amendment.OrderId, *priceChange.Before, *priceChange.After)
+						
+						if decimalEqual(*priceChange.Before, orderPriceStr) && decimalEqual(*priceChange.After, newPriceStr) {
+							found = true
+						}
 					}
 					
-					// Clean up: Cancel the test order
-					cancelReq := client.FuturesAPI.DeleteOrderV1(ctx).
-						Symbol(symbol).
-						OrderId(orderId).
-						Timestamp(generateTimestamp())
+					if !found {
+						t.Fatalf("No amendment record shows price %s -> %s", orderPriceStr, newPriceStr)
+					}
+				})
+			})
+			break
+		}
+	}
+}
+
+// Binance error codes returned by position mode changes and hedge-mode orders
+const (
+	errCodeNoNeedToChangePositionSide = -4059
+	errCodePositionSideMismatch       = -4061
+	errCodePositionSideHasOrders      = -4067
+	errCodePositionSideHasPosition    = -4068
+)
+
+// setPositionSideDual switches between hedge (true) and one-way (false) mode, treating
+// "no need to change" as success
+func setPositionSideDual(client *openapi.APIClient, ctx context.Context, dual bool) error {
+	_, _, err := client.FuturesAPI.CreatePositionSideDualV1(ctx).
+		DualSidePosition(fmt.Sprintf("%t", dual)).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if code, ok := apiErrorCode(err); ok && code == errCodeNoNeedToChangePositionSide {
+		return nil
+	}
+	return err
+}
+
+// TestHedgeModeOrders tests placing LONG and SHORT position-side orders in hedge mode and
+// that an order without a matching position side is rejected
+func TestHedgeModeOrders(t *testing.T) {
+	// Skip if position mode change or trading is not enabled
+	if os.Getenv("BINANCE_TEST_CMFUTURES_POSITION_MODE") != "true" {
+		t.Skip("Position mode change disabled. Set BINANCE_TEST_CMFUTURES_POSITION_MODE=true to enable")
+	}
+	if os.Getenv("BINANCE_TEST_CMFUTURES_TRADING") != "true" {
+		t.Skip("Trading operations disabled. Set BINANCE_TEST_CMFUTURES_TRADING=true to enable")
+	}
+
+	configs := getTestConfigs()
+	for _, config := range configs {
+		if config.AuthType == AuthTypeTRADE {
+			t.Run(config.Name, func(t *testing.T) {
+				testEndpoint(t, config, "HedgeModeOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+					symbol := getTestSymbol()
+					requireTrading(t, symbol)
+					
+					getResp, httpResp, err := client.FuturesAPI.GetPositionSideDualV1(ctx).
+						Timestamp(generateTimestamp()).
+						Execute()
+					if handleTestnetError(t, err, httpResp, "HedgeModeOrders") {
+						return
+					}
+					if err != nil || getResp.DualSidePosition == nil {
+						t.Fatalf("Failed to get position side dual: %v", err)
+					}
+					originalMode := *getResp.DualSidePosition
+					
+					if err := setPositionSideDual(client, ctx, true); err != nil {
+						if code, ok := apiErrorCode(err); ok && (code == errCodePositionSideHasOrders || code == errCodePositionSideHasPosition) {
+							t.Skipf("Cannot switch to hedge mode while the account has open orders or positions (code %d)", code)
+						}
+						checkAPIError(t, err, nil, "HedgeModeOrders")
+						t.Fatalf("Failed to enable hedge mode: %v", err)
+					}
+					// Deferred calls run last-in first-out: orders are cancelled and positions
+					// flattened before the original mode is restored
+					defer func() {
+						time.Sleep(100 * time.Millisecond)
+						if err := setPositionSideDual(client, ctx, originalMode); err != nil {
+							t.Errorf("Failed to restore dualSidePosition=%t: %v", originalMode, err)
+							return
+						}
+						t.Logf("Restored dualSidePosition=%t", originalMode)
+					}()
+					defer flattenHedgePositions(t, client, ctx, symbol)
+					
+					currentPrice, priceErr := getCurrentPrice(client, ctx, symbol)
+					if priceErr != nil {
+						t.Fatalf("Failed to get current price: %v", priceErr)
+					}
+					
+					// Both legs rest away from the market: LONG bids below, SHORT offers above
+					legs := []struct {
+						side         string
+						positionSide string
+						price        string
+					}{
+						{"BUY", "LONG", fmt.Sprintf("%.1f", currentPrice*0.98)},
+						{"SELL", "SHORT", fmt.Sprintf("%.1f", currentPrice*1.02)},
+					}
+					
+					for _, leg := range legs {
+						resp, httpResp, err := client.FuturesAPI.CreateOrderV1(ctx).
+							Symbol(symbol).
+							Side(leg.side).
+							PositionSide(leg.positionSide).
+							Type_("LIMIT").
+							TimeInForce("GTC").
+							Quantity("1").
+							Price(leg.price).
+							Timestamp(generateTimestamp()).
+							Execute()
+						if err != nil {
+							checkAPIError(t, err, httpResp, "HedgeModeOrders")
+							t.Fatalf("Failed to place %s %s order: %v", leg.positionSide, leg.side, err)
+						}
+						if resp.OrderId == nil {
+							t.Fatalf("%s order returned no orderId", leg.positionSide)
+						}
+						
+						orderId := *resp.OrderId
+						defer func() {
+							_, _, cancelErr := client.FuturesAPI.DeleteOrderV1(ctx).
+								Symbol(symbol).
+								OrderId(orderId).
+								Timestamp(generateTimestamp()).
+								Execute()
+							if cancelErr != nil {
+								t.Logf("Warning: Failed to cancel %s order %d: %v", leg.positionSide, orderId, cancelErr)
+							}
+						}()
+						
+						if resp.PositionSide == nil || *resp.PositionSide != leg.positionSide {
+							t.Errorf("Expected positionSide %s echoed for order %d, got %v", leg.positionSide, orderId, resp.PositionSide)
+						}
+						t.Logf("Placed hedge-mode order: id=%d side=%s positionSide=%s price=%s", orderId, leg.side, leg.positionSide, leg.price)
+					}
 					
-					_, _, cancelErr := cancelReq.Execute()
-					if cancelErr != nil {
-						t.Logf("Warning: Failed to cancel test order %d: %v", orderId, cancelErr)
+					// In hedge mode an order left on the default BOTH position side must be rejected
+					resp, httpResp, err := client.FuturesAPI.CreateOrderV1(ctx).
+						Symbol(symbol).
+						Side("BUY").
+						Type_("LIMIT").
+						TimeInForce("GTC").
+						Quantity("1").
+						Price(legs[0].price).
+						Timestamp(generateTimestamp()).
+						Execute()
+					if err == nil {
+						if resp.OrderId != nil {
+							client.FuturesAPI.DeleteOrderV1(ctx).
+								Symbol(symbol).
+								OrderId(*resp.OrderId).
+								Timestamp(generateTimestamp()).
+								Execute()
+						}
+						t.Fatal("Expected an order without positionSide to be rejected in hedge mode")
+					}
+					if code, ok := apiErrorCode(err); !ok || code != errCodePositionSideMismatch {
+						t.Errorf("Expected error code %d (position side does not match), got %v (status %v)", errCodePositionSideMismatch, err, httpResp)
 					} else {
-						t.Logf("Successfully cancelled test order %d", orderId)
+						t.Logf("Order without positionSide rejected as expected (code %d)", code)
 					}
 				})
 			})
@@ -1236,6 +1792,48 @@ func TestOrderAmendment(t *testing.T) {
 	}
 }
 
+// flattenHedgePositions closes any LONG or SHORT position on symbol left behind by a filled hedge-mode order
+func flattenHedgePositions(t *testing.T, client *openapi.APIClient, ctx context.Context, symbol string) {
+	t.Helper()
+	
+	positions, _, err := client.FuturesAPI.GetPositionRiskV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		t.Logf("Warning: Failed to check positions for %s: %v", symbol, err)
+		return
+	}
+	
+	for _, position := range positions {
+		if position.Symbol == nil || *position.Symbol != symbol || position.PositionSide == nil || position.PositionAmt == nil {
+			continue
+		}
+		amount := strings.TrimPrefix(*position.PositionAmt, "-")
+		if decimalEqual(amount, "0") {
+			continue
+		}
+		
+		// A LONG position is closed by selling, a SHORT one by buying, on the same position side
+		side := "SELL"
+		if *position.PositionSide == "SHORT" {
+			side = "BUY"
+		}
+		_, _, closeErr := client.FuturesAPI.CreateOrderV1(ctx).
+			Symbol(symbol).
+			Side(side).
+			PositionSide(*position.PositionSide).
+			Type_("MARKET").
+			Quantity(amount).
+			Timestamp(generateTimestamp()).
+			Execute()
+		if closeErr != nil {
+			t.Errorf("Failed to flatten %s position of %s on %s: %v", *position.PositionSide, amount, symbol, closeErr)
+			continue
+		}
+		t.Logf("Flattened %s position of %s on %s", *position.PositionSide, amount, symbol)
+	}
+}
+
 // TestUserTrades tests getting user trades
 func TestUserTrades(t *testing.T) {
 	configs := getTestConfigs()
@@ -1261,6 +1859,15 @@ func TestUserTrades(t *testing.T) {
 					}
 					
 					t.Logf("User trades for %s: count=%d", symbol, len(resp))
+					assertEmptySlice(t, resp, "userTrades")
+					
+					times := make([]int64, 0, len(resp))
+					for _, trade := range resp {
+						if trade.Time != nil {
+							times = append(times, *trade.Time)
+						}
+					}
+					assertAscendingByTime(t, times, "userTrades.time")
 					
 					// Check structure of first trade if any exist
 					if len(resp) > 0 {
@@ -1334,4 +1941,4 @@ func TestCommissionRate(t *testing.T) {
 			break
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,3 @@
+module github.com/openxapi/integration-tests/src/binance/go/rest/httpdebug
+
+go 1.24.1
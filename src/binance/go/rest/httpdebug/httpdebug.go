@@ -0,0 +1,60 @@
+// Package httpdebug dumps REST requests and responses to a test log with credentials masked.
+// It wraps a single client's transport, so only the requests made through that client are dumped
+// and the process-wide logger is left alone.
+package httpdebug
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+)
+
+// secretPatterns match credentials that appear in request dumps
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(X-Mbx-Apikey:\s*)\S+`),
+	regexp.MustCompile(`(?i)(signature=)[0-9a-zA-Z%+/=]+`),
+}
+
+// Scrub masks API keys and signatures in a dump
+func Scrub(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "${1}[REDACTED]")
+	}
+	return s
+}
+
+// Transport dumps every request and response it carries to Logf, then hands the request to Base
+type Transport struct {
+	// Base performs the request; http.DefaultTransport when nil
+	Base http.RoundTripper
+	// Logf receives the scrubbed dumps, typically a test's Logf
+	Logf func(format string, args ...interface{})
+}
+
+// RoundTrip implements http.RoundTripper
+func (tr *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := tr.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		tr.Logf("HTTP request:\n%s", Scrub(strings.TrimRight(string(dump), "\r\n")))
+	} else {
+		tr.Logf("HTTP request %s %s could not be dumped: %v", req.Method, req.URL.Path, err)
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		tr.Logf("HTTP request %s %s failed: %v", req.Method, req.URL.Path, err)
+		return nil, err
+	}
+
+	if dump, dumpErr := httputil.DumpResponse(resp, true); dumpErr == nil {
+		tr.Logf("HTTP response:\n%s", Scrub(strings.TrimRight(string(dump), "\r\n")))
+	} else {
+		tr.Logf("HTTP response %s could not be dumped: %v", resp.Status, dumpErr)
+	}
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+package httpdebug
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestScrub verifies API keys and signatures are masked and the rest of the dump is kept
+func TestScrub(t *testing.T) {
+	dump := "GET /fapi/v1/order?symbol=BTCUSDT&signature=abc123 HTTP/1.1\r\nX-Mbx-Apikey: secret-key\r\n"
+	got := Scrub(dump)
+	if strings.Contains(got, "abc123") || strings.Contains(got, "secret-key") {
+		t.Errorf("Expected credentials to be scrubbed, got %q", got)
+	}
+	if !strings.Contains(got, "symbol=BTCUSDT") || strings.Count(got, "[REDACTED]") != 2 {
+		t.Errorf("Expected the request line kept and both credentials redacted, got %q", got)
+	}
+}
+
+// TestTransport verifies the request and response are dumped, scrubbed, and still delivered intact
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"received":%q}`, string(body))
+	}))
+	defer server.Close()
+
+	var logs []string
+	client := &http.Client{Transport: &Transport{Logf: func(format string, args ...interface{}) {
+		logs = append(logs, fmt.Sprintf(format, args...))
+	}}}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/fapi/v1/batchOrders", strings.NewReader("batchOrders=%5B%5D&signature=abc123"))
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("X-MBX-APIKEY", "secret-key")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response: %v", err)
+	}
+	if !strings.Contains(string(body), "signature=abc123") {
+		t.Errorf("Expected the server to receive the unmodified body, got %s", body)
+	}
+
+	if len(logs) != 2 {
+		t.Fatalf("Expected a request and a response dump, got %d: %v", len(logs), logs)
+	}
+	if !strings.Contains(logs[0], "POST /fapi/v1/batchOrders") {
+		t.Errorf("Expected the request line in the request dump, got %q", logs[0])
+	}
+	if !strings.Contains(logs[1], "200 OK") || !strings.Contains(logs[1], "received") {
+		t.Errorf("Expected the status and body in the response dump, got %q", logs[1])
+	}
+	for _, entry := range logs {
+		if strings.Contains(entry, "abc123") || strings.Contains(entry, "secret-key") {
+			t.Errorf("Expected credentials to be scrubbed, got %q", entry)
+		}
+	}
+}
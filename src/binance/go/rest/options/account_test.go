@@ -1,6 +1,8 @@
 package main
 
 import (
+	"math"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -12,7 +14,7 @@ func testAccountInfo(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetAccountV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetAccountV1") {
@@ -96,7 +98,7 @@ func testPositionInfo(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetPositionV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetPositionV1") {
@@ -170,7 +172,7 @@ func testMarginAccountInfo(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetMarginAccountV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetMarginAccountV1") {
@@ -258,7 +260,7 @@ func testAccountBill(t *testing.T) {
 	
 	resp, httpResp, err := client.OptionsAPI.GetBillV1(ctx).
 		Currency("USDT").
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetBillV1") {
@@ -314,7 +316,7 @@ func testUserTrades(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetUserTradesV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetUserTradesV1") {
@@ -379,7 +381,7 @@ func testBlockUserTrades(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetBlockUserTradesV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetBlockUserTradesV1") {
@@ -452,7 +454,7 @@ func testExerciseRecord(t *testing.T) {
 	rateLimiter.WaitForRateLimit()
 	
 	resp, httpResp, err := client.OptionsAPI.GetExerciseRecordV1(ctx).
-		Timestamp(time.Now().UnixMilli()).
+		Timestamp(generateTimestamp()).
 		Execute()
 	
 	if handleOptionsSpecificErrors(t, err, httpResp, "GetExerciseRecordV1") {
@@ -554,4 +556,145 @@ func testIncomeAsync(t *testing.T) {
 	if resp.AvgCostTimestampOfLast30d != nil {
 		t.Logf("  Avg Cost Timestamp Of Last 30d: %d", *resp.AvgCostTimestampOfLast30d)
 	}
-}
\ No newline at end of file
+}
+
+const (
+	// greekDeltaAbsTolerance and greekDeltaRelTolerance bound how far the account's aggregate delta may
+	// drift from the sum of position deltas; marks and the account snapshot are fetched moments apart
+	greekDeltaAbsTolerance = 0.01
+	greekDeltaRelTolerance = 0.05
+)
+
+// parseGreek parses a greek returned as a decimal string, failing the test if it is not a float
+func parseGreek(t *testing.T, underlying, name string, value *string) float64 {
+	t.Helper()
+	if value == nil {
+		t.Errorf("%s: %s is missing", underlying, name)
+		return 0
+	}
+	f, err := strconv.ParseFloat(*value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		t.Errorf("%s: %s %q does not parse as a finite float: %v", underlying, name, *value, err)
+		return 0
+	}
+	return f
+}
+
+// testAccountGreeks tests that the account's per-underlying greeks parse as floats and that each
+// aggregate delta matches the sum of position deltas, computed as mark delta times signed quantity
+func testAccountGreeks(t *testing.T) {
+	client, ctx := getTestClientAndContext(t)
+	
+	rateLimiter.WaitForRateLimit()
+	account, httpResp, err := client.OptionsAPI.GetAccountV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if handleOptionsSpecificErrors(t, err, httpResp, "GetAccountV1") {
+		return
+	}
+	if err != nil {
+		t.Fatalf("GetAccountV1 failed: %v", err)
+	}
+	if len(account.Greek) == 0 {
+		t.Skip("Options account exposes no greeks; nothing to check")
+	}
+	
+	rateLimiter.WaitForRateLimit()
+	positions, httpResp, err := client.OptionsAPI.GetPositionV1(ctx).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if handleOptionsSpecificErrors(t, err, httpResp, "GetPositionV1") {
+		return
+	}
+	if err != nil {
+		t.Fatalf("GetPositionV1 failed: %v", err)
+	}
+	
+	rateLimiter.WaitForRateLimit()
+	marks, httpResp, err := client.OptionsAPI.GetMarkV1(ctx).Execute()
+	if handleOptionsSpecificErrors(t, err, httpResp, "GetMarkV1") {
+		return
+	}
+	if err != nil {
+		t.Fatalf("GetMarkV1 failed: %v", err)
+	}
+	markDelta := make(map[string]float64, len(marks))
+	for _, mark := range marks {
+		if mark.Symbol == nil || mark.Delta == nil {
+			continue
+		}
+		if delta, err := strconv.ParseFloat(*mark.Delta, 64); err == nil {
+			markDelta[*mark.Symbol] = delta
+		}
+	}
+	
+	rateLimiter.WaitForRateLimit()
+	exchangeInfo, httpResp, err := client.OptionsAPI.GetExchangeInfoV1(ctx).Execute()
+	if handleOptionsSpecificErrors(t, err, httpResp, "GetExchangeInfoV1") {
+		return
+	}
+	if err != nil {
+		t.Fatalf("GetExchangeInfoV1 failed: %v", err)
+	}
+	// Each contract's underlying (e.g. BTCUSDT) and unit, the underlying quantity one contract covers
+	type contractSpec struct {
+		underlying string
+		unit       float64
+	}
+	contracts := make(map[string]contractSpec, len(exchangeInfo.OptionSymbols))
+	for _, optionSymbol := range exchangeInfo.OptionSymbols {
+		if optionSymbol.Symbol != nil && optionSymbol.Underlying != nil && optionSymbol.Unit != nil {
+			contracts[*optionSymbol.Symbol] = contractSpec{*optionSymbol.Underlying, float64(optionSymbol.GetUnit())}
+		}
+	}
+	
+	// The account reports greeks per underlying, in units of the underlying asset
+	positionDelta := make(map[string]float64)
+	for _, position := range positions {
+		if position.Symbol == nil || position.Quantity == nil {
+			continue
+		}
+		quantity, err := strconv.ParseFloat(*position.Quantity, 64)
+		if err != nil {
+			t.Errorf("Position %s quantity %q does not parse: %v", *position.Symbol, *position.Quantity, err)
+			continue
+		}
+		delta, ok := markDelta[*position.Symbol]
+		if !ok {
+			t.Errorf("No mark delta for position %s", *position.Symbol)
+			continue
+		}
+		contract, ok := contracts[*position.Symbol]
+		if !ok {
+			t.Errorf("Position %s is not listed in exchangeInfo", *position.Symbol)
+			continue
+		}
+		quantity = math.Abs(quantity)
+		if position.Side != nil && *position.Side == "SHORT" {
+			quantity = -quantity
+		}
+		positionDelta[contract.underlying] += delta * quantity * contract.unit
+	}
+	
+	t.Logf("Aggregate greeks across %d underlyings and %d positions:", len(account.Greek), len(positions))
+	for _, greek := range account.Greek {
+		if greek.Underlying == nil {
+			t.Error("Greek entry has no underlying")
+			continue
+		}
+		underlying := *greek.Underlying
+		delta := parseGreek(t, underlying, "delta", greek.Delta)
+		gamma := parseGreek(t, underlying, "gamma", greek.Gamma)
+		theta := parseGreek(t, underlying, "theta", greek.Theta)
+		vega := parseGreek(t, underlying, "vega", greek.Vega)
+		
+		summed := positionDelta[underlying]
+		tolerance := math.Max(greekDeltaAbsTolerance, greekDeltaRelTolerance*math.Abs(delta))
+		if math.Abs(delta-summed) > tolerance {
+			t.Errorf("%s: aggregate delta %.6f differs from summed position delta %.6f by more than %.6f",
+				underlying, delta, summed, tolerance)
+		}
+		t.Logf("  %s: delta=%.6f (positions %.6f) gamma=%.6f theta=%.6f vega=%.6f",
+			underlying, delta, summed, gamma, theta, vega)
+	}
+}
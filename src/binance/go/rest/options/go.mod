@@ -2,8 +2,19 @@ module github.com/openxapi/integration-tests/src/binance/go/rest/options
 
 go 1.24.1
 
-require github.com/openxapi/binance-go/rest v0.0.0
+require (
+	github.com/openxapi/binance-go/rest v0.0.0
+	github.com/openxapi/integration-tests/src/binance/go/proxyenv v0.0.0
+	github.com/openxapi/integration-tests/src/binance/go/rest/clientpool v0.0.0
+	github.com/openxapi/integration-tests/src/binance/go/rest/timestamp v0.0.0
+)
 
 require gopkg.in/validator.v2 v2.0.1 // indirect
 
 replace github.com/openxapi/binance-go/rest => ../../../../../../binance-go/rest
+
+replace github.com/openxapi/integration-tests/src/binance/go/proxyenv => ../../proxyenv
+
+replace github.com/openxapi/integration-tests/src/binance/go/rest/clientpool => ../clientpool
+
+replace github.com/openxapi/integration-tests/src/binance/go/rest/timestamp => ../timestamp
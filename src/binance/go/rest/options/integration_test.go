@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"testing"
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/options"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // AuthType represents the type of authentication
@@ -146,6 +149,9 @@ func setupClient(config TestConfig) (*openapi.APIClient, context.Context) {
 		}
 	}
 
+	// Each client owns its transport, routed through BINANCE_TEST_HTTP_PROXY when set
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+
 	// Create client
 	client := openapi.NewAPIClient(cfg)
 	ctx := context.Background()
@@ -259,6 +265,13 @@ func initializeTests() []TestInfo {
 		Category:     "Market Data",
 	})
 
+	tests = append(tests, TestInfo{
+		Name:         "Market Data - Settlement",
+		Function:     testMarketDataSettlement,
+		AuthRequired: AuthTypeNONE,
+		Category:     "Market Data",
+	})
+
 	// DISABLED FOR PRODUCTION SAFETY: Account and trading tests excluded
 	// Uncomment below to enable account/trading tests (requires production credentials)
 	
@@ -306,6 +319,13 @@ func initializeTests() []TestInfo {
 		Category:     "Account",
 	})
 
+	tests = append(tests, TestInfo{
+		Name:         "Account - Greeks",
+		Function:     testAccountGreeks,
+		AuthRequired: AuthTypeTRADE,
+		Category:     "Account",
+	})
+
 	tests = append(tests, TestInfo{
 		Name:         "Account - Exercise Record",
 		Function:     testExerciseRecord,
@@ -339,6 +359,32 @@ func initializeTests() []TestInfo {
 	return tests
 }
 
+// generateTimestamp generates a timestamp for API requests from the shared request clock
+func generateTimestamp() int64 {
+	return timestamp.Now()
+}
+
+// syncRequestClock aligns the shared request clock with the configured server, so signed requests
+// are not rejected for clock drift (-1021). The local clock is kept if the server time is unavailable.
+func syncRequestClock() {
+	client, ctx := setupClient(TestConfig{Name: "Request Clock"})
+	err := timestamp.Sync(func() (int64, error) {
+		resp, _, err := client.OptionsAPI.GetTimeV1(ctx).Execute()
+		if err != nil {
+			return 0, err
+		}
+		if resp.ServerTime == nil {
+			return 0, fmt.Errorf("response has no serverTime")
+		}
+		return *resp.ServerTime, nil
+	})
+	if err != nil {
+		fmt.Printf("Using the local clock for request timestamps: %v\n", err)
+		return
+	}
+	fmt.Printf("Request clock offset from server: %d ms\n", timestamp.Offset())
+}
+
 // TestFullIntegrationSuite runs all integration tests with emoji output - Public endpoints only
 func TestFullIntegrationSuite(t *testing.T) {
 	tests := initializeTests()
@@ -423,14 +469,16 @@ func getTestClientAndContext(t *testing.T) (*openapi.APIClient, context.Context)
 	configs := getTestConfigs()
 	for _, config := range configs {
 		if config.AuthType == AuthTypeTRADE {
-			client, ctx := setupClient(config)
+			client, ctx := pooledClientFor(config)
+			requireOptionsAPI(t, client, ctx)
 			return client, ctx
 		}
 	}
 	
 	// Fallback to public endpoints if no auth available
 	if len(configs) > 0 {
-		client, ctx := setupClient(configs[0])
+		client, ctx := pooledClientFor(configs[0])
+		requireOptionsAPI(t, client, ctx)
 		return client, ctx
 	}
 	
@@ -458,3 +506,55 @@ func parseIntOrDefault(s string, defaultValue int) int {
 	return defaultValue
 }
 
+// TestServerConfig tests that setupClient targets the base URL selected by BINANCE_OPTIONS_REST_SERVER
+func TestServerConfig(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"Production", "", "https://eapi.binance.com"},
+		{"Override", "https://eapi.binance.test", "https://eapi.binance.test"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("BINANCE_OPTIONS_REST_SERVER", tc.value)
+			client, _ := setupClient(TestConfig{Name: "Server Config", AuthType: AuthTypeNONE})
+
+			servers := client.GetConfig().Servers
+			if len(servers) == 0 {
+				t.Fatal("Client has no server configuration")
+			}
+			if got := servers[0].URL; got != tc.want {
+				t.Fatalf("Active server URL is %s, want %s: tests would hit the wrong environment", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestOptionsUnavailable tests which ping outcomes make requireOptionsAPI skip
+func TestOptionsUnavailable(t *testing.T) {
+	pingErr := errors.New("ping failed")
+	
+	cases := []struct {
+		name     string
+		httpResp *http.Response
+		err      error
+		skip     bool
+	}{
+		{"Answered", &http.Response{StatusCode: 200}, nil, false},
+		{"Unreachable", nil, pingErr, true},
+		{"NotServed", &http.Response{StatusCode: 404}, pingErr, true},
+		{"RegionBlocked", &http.Response{StatusCode: 451}, pingErr, true},
+		{"RateLimited", &http.Response{StatusCode: 429}, pingErr, false},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if reason := optionsUnavailable(tc.httpResp, tc.err); (reason != "") != tc.skip {
+				t.Errorf("Expected skip=%t, got reason %q", tc.skip, reason)
+			}
+		})
+	}
+}
@@ -16,6 +16,15 @@ func TestMain(m *testing.M) {
 	fmt.Println("🔴 IMPORTANT: These tests will interact with real Options API endpoints")
 	fmt.Println()
 
+	// Fail fast on an unusable proxy instead of timing out every request
+	if err := configureHTTPProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Align request timestamps with the server clock before any signed request
+	syncRequestClock()
+
 	// Run tests
 	code := m.Run()
 
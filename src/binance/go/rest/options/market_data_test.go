@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -451,6 +453,78 @@ func testMarketDataOpenInterest(t *testing.T) {
 	}
 }
 
+const (
+	// settlementUnderlying is the underlying whose exercise history testMarketDataSettlement reads
+	settlementUnderlying = "BTCUSDT"
+	// settlementLookback bounds "recently expired"; daily expiries mean this always spans several
+	settlementLookback = 7 * 24 * time.Hour
+)
+
+// testMarketDataSettlement tests the exercise history of recently expired contracts. The API reports
+// the settlement price as realStrikePrice and the exercise time as expiryDate; both must be
+// present, with a positive settle price and an exercise time in the past that matches the
+// expiry encoded in the symbol.
+func testMarketDataSettlement(t *testing.T) {
+	client, ctx := getTestClientAndContext(t)
+	
+	rateLimiter.WaitForRateLimit()
+	
+	now := time.Now()
+	resp, httpResp, err := client.OptionsAPI.GetExerciseHistoryV1(ctx).
+		Underlying(settlementUnderlying).
+		StartTime(now.Add(-settlementLookback).UnixMilli()).
+		EndTime(now.UnixMilli()).
+		Execute()
+	
+	if handleOptionsSpecificErrors(t, err, httpResp, "GetExerciseHistoryV1") {
+		return
+	}
+	
+	if err != nil {
+		t.Fatalf("GetExerciseHistoryV1 failed: %v", err)
+	}
+	
+	if len(resp) == 0 {
+		t.Skipf("No %s settlements in the last %v", settlementUnderlying, settlementLookback)
+	}
+	
+	for i, record := range resp {
+		symbol := getStringValue(record.Symbol)
+		expiry, ok := parseOptionsSymbolExpiry(symbol)
+		if !ok {
+			t.Errorf("Settlement %d: symbol %q is not in BASE-YYMMDD-STRIKE-C/P format", i, symbol)
+		}
+		
+		if strike, err := strconv.ParseFloat(getStringValue(record.StrikePrice), 64); err != nil || strike <= 0 {
+			t.Errorf("Settlement %d (%s): strikePrice %q is not a positive number", i, symbol, getStringValue(record.StrikePrice))
+		}
+		
+		if record.RealStrikePrice == nil {
+			t.Errorf("Settlement %d (%s): realStrikePrice (settle price) is missing", i, symbol)
+		} else if settle, err := strconv.ParseFloat(*record.RealStrikePrice, 64); err != nil || settle <= 0 {
+			t.Errorf("Settlement %d (%s): settle price %q is not a positive number", i, symbol, *record.RealStrikePrice)
+		}
+		
+		if record.ExpiryDate == nil {
+			t.Errorf("Settlement %d (%s): expiryDate (exercise time) is missing", i, symbol)
+			continue
+		}
+		exercised := time.UnixMilli(*record.ExpiryDate)
+		if !exercised.Before(now) {
+			t.Errorf("Settlement %d (%s): exercise time %s is not in the past", i, symbol, exercised.UTC().Format(time.RFC3339))
+		}
+		if ok && exercised.UTC().Format("060102") != expiry.Format("060102") {
+			t.Errorf("Settlement %d (%s): exercised on %s, but the symbol expires %s",
+				i, symbol, exercised.UTC().Format("2006-01-02"), expiry.Format("2006-01-02"))
+		}
+	}
+	
+	first := resp[0]
+	t.Logf("%d %s settlements in the last %v; first: %s strike=%s settle=%s result=%s",
+		len(resp), settlementUnderlying, settlementLookback, getStringValue(first.Symbol),
+		getStringValue(first.StrikePrice), getStringValue(first.RealStrikePrice), getStringValue(first.StrikeResult))
+}
+
 // Helper functions
 
 func getTestOptionsSymbol(t *testing.T, client *openapi.APIClient, ctx context.Context) string {
@@ -480,6 +554,22 @@ func getTestOptionsSymbol(t *testing.T, client *openapi.APIClient, ctx context.C
 	return ""
 }
 
+// optionsSymbolPattern matches option symbols such as BTC-240628-60000-C
+var optionsSymbolPattern = regexp.MustCompile(`^([A-Z0-9]+)-(\d{6})-(\d+(?:\.\d+)?)-([CP])$`)
+
+// parseOptionsSymbolExpiry validates the option symbol format and returns its expiry date (UTC)
+func parseOptionsSymbolExpiry(symbol string) (time.Time, bool) {
+	match := optionsSymbolPattern.FindStringSubmatch(symbol)
+	if match == nil {
+		return time.Time{}, false
+	}
+	expiry, err := time.Parse("060102", match[2])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiry, true
+}
+
 func getStringValue(s *string) string {
 	if s == nil {
 		return ""
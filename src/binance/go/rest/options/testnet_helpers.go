@@ -1,14 +1,50 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
 	openapi "github.com/openxapi/binance-go/rest/options"
 )
 
+// The Options API is pinged once per run; an empty reason means it answered
+var (
+	optionsPingOnce    sync.Once
+	optionsUnreachable string
+)
+
+// optionsUnavailable returns why a ping outcome shows eapi is not reachable from here, or ""
+// when it answered at all; options has no testnet, so this is usually a network or region block
+func optionsUnavailable(httpResp *http.Response, err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case httpResp == nil:
+		return fmt.Sprintf("server unreachable: %v", err)
+	case httpResp.StatusCode == http.StatusNotFound || httpResp.StatusCode == http.StatusUnavailableForLegalReasons:
+		return fmt.Sprintf("HTTP %d", httpResp.StatusCode)
+	}
+	return ""
+}
+
+// requireOptionsAPI skips t when the Options API cannot be reached on the configured server
+func requireOptionsAPI(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+	t.Helper()
+	optionsPingOnce.Do(func() {
+		_, httpResp, err := client.OptionsAPI.GetPingV1(ctx).Execute()
+		optionsUnreachable = optionsUnavailable(httpResp, err)
+	})
+	if optionsUnreachable != "" {
+		t.Skipf("Options API not available (%s); check network access to eapi.binance.com "+
+			"or point BINANCE_OPTIONS_REST_SERVER at a reachable host", optionsUnreachable)
+	}
+}
+
 // handleTestnetError checks if error is due to testnet limitations and skips test if so
 // Returns true if test should be skipped, false if test should continue
 // NEVER skips 400 Bad Request errors - these indicate real API issues that need fixing
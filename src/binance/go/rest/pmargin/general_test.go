@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
 
 	openapi "github.com/openxapi/binance-go/rest/pmargin"
@@ -37,4 +40,92 @@ func TestPing(t *testing.T) {
 			break // Only need to test ping once
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestPAPIUnavailable tests which ping outcomes make requirePAPI skip
+func TestPAPIUnavailable(t *testing.T) {
+	cases := []struct {
+		name     string
+		httpResp *http.Response
+		err      error
+		skip     bool
+	}{
+		{"Answered", &http.Response{StatusCode: 200}, nil, false},
+		{"Unreachable", nil, errors.New("dial tcp: i/o timeout"), true},
+		{"RejectedKey", &http.Response{StatusCode: 401}, bodyError{body: `{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`}, false},
+		{"HTMLErrorPage", &http.Response{StatusCode: 404}, bodyError{body: "<html>Not Found</html>"}, true},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if reason := papiUnavailable(tc.httpResp, tc.err); (reason != "") != tc.skip {
+				t.Errorf("Expected skip=%t, got reason %q", tc.skip, reason)
+			}
+		})
+	}
+}
+
+// bodyError mimics the SDK error's Body accessor for classification tests
+type bodyError struct {
+	body string
+}
+
+func (e bodyError) Error() string { return "401 Unauthorized" }
+func (e bodyError) Body() []byte  { return []byte(e.body) }
+
+// TestClassifyAPIErrorGuidance tests that -2015 errors produce actionable guidance
+func TestClassifyAPIErrorGuidance(t *testing.T) {
+	guidance := classifyAPIError(bodyError{body: `{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`})
+	for _, want := range []string{"-2015", "Invalid API-key, IP, or permissions", "IP allowlist", "Key permissions", "Key type"} {
+		if !strings.Contains(guidance, want) {
+			t.Errorf("Expected guidance to mention %q, got:\n%s", want, guidance)
+		}
+	}
+	if strings.Count(guidance, "\n") < 3 {
+		t.Errorf("Expected multi-line guidance, got:\n%s", guidance)
+	}
+	
+	noGuidance := []struct {
+		name string
+		err  error
+	}{
+		{"Nil", nil},
+		{"OtherCode", bodyError{body: `{"code":-1121,"msg":"Invalid symbol."}`}},
+		{"NonJSONBody", bodyError{body: "<html>Forbidden</html>"}},
+		{"PlainError", errors.New("connection reset")},
+	}
+	for _, tc := range noGuidance {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := classifyAPIError(tc.err); got != "" {
+				t.Errorf("Expected no guidance, got:\n%s", got)
+			}
+		})
+	}
+}
+
+// TestServerConfig tests that setupClient targets the base URL selected by BINANCE_PMARGIN_TESTNET_SUPPORTED
+func TestServerConfig(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"Production", "", "https://papi.binance.com"},
+		{"TestnetSupported", "true", "https://testnet.binance.vision"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("BINANCE_PMARGIN_TESTNET_SUPPORTED", tc.value)
+			client, _ := setupClient(TestConfig{Name: "Server Config", AuthType: AuthTypeNONE})
+
+			servers := client.GetConfig().Servers
+			if len(servers) == 0 {
+				t.Fatal("Client has no server configuration")
+			}
+			if got := servers[0].URL; got != tc.want {
+				t.Fatalf("Active server URL is %s, want %s: tests would hit the wrong environment", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/pmargin"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // AuthType represents the type of authentication
@@ -158,6 +159,9 @@ func setupClient(config TestConfig) (*openapi.APIClient, context.Context) {
 		}
 	}
 
+	// Each client owns its transport, routed through BINANCE_TEST_HTTP_PROXY when set
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+
 	// Create client
 	client := openapi.NewAPIClient(cfg)
 	ctx := context.Background()
@@ -260,12 +264,14 @@ func loadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
 func testEndpoint(t *testing.T, config TestConfig, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
 	rateLimiter.WaitForRateLimit()
 
-	client, ctx := setupClient(config)
+	client, ctx := pooledClientFor(config)
 	
 	// Create a context with timeout for the HTTP requests
 	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	
+	requirePAPI(t, client, timeoutCtx)
+	
 	// Run test function directly - t.Fatal will properly fail the test immediately
 	testFunc(t, client, timeoutCtx)
 }
@@ -322,11 +328,16 @@ func checkAPIError(t *testing.T, err error, httpResp *http.Response) {
 			t.Logf("Non-API Error: %v", err)
 		}
 	}
+
+	// Fail with guidance instead of a raw error for commonly misdiagnosed codes
+	if guidance := classifyAPIError(err); guidance != "" {
+		t.Fatal(guidance)
+	}
 }
 
-// generateTimestamp generates a timestamp for API requests
+// generateTimestamp generates a timestamp for API requests from the shared request clock
 func generateTimestamp() int64 {
-	return time.Now().UnixMilli()
+	return timestamp.Now()
 }
 
 // TestFullIntegrationSuite runs all integration tests
@@ -496,7 +507,7 @@ func getTestClient(t *testing.T) *openapi.APIClient {
 	configs := getTestConfigs()
 	for _, config := range configs {
 		if config.AuthType == AuthTypeTRADE {
-			client, _ := setupClient(config)
+			client, _ := pooledClientFor(config)
 			return client
 		}
 	}
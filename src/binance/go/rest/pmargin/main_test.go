@@ -15,6 +15,12 @@ func TestMain(m *testing.M) {
 	fmt.Println("Using testnet server by default")
 	fmt.Println()
 
+	// Fail fast on an unusable proxy instead of timing out every request
+	if err := configureHTTPProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run tests
 	code := m.Run()
 
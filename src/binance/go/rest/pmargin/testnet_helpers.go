@@ -1,14 +1,56 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	openapi "github.com/openxapi/binance-go/rest/pmargin"
 )
 
+// PAPI is pinged once per run; an empty reason means the configured host serves it
+var (
+	papiPingOnce    sync.Once
+	papiUnreachable string
+)
+
+// papiUnavailable returns why a ping outcome shows the configured host does not serve PAPI,
+// or "" when it answered like the API, including with a JSON error such as -2015
+func papiUnavailable(httpResp *http.Response, err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case httpResp == nil:
+		return fmt.Sprintf("server unreachable: %v", err)
+	case json.Valid(apiErrorBody(err)):
+		return ""
+	}
+	return fmt.Sprintf("HTTP %d without a Binance error body", httpResp.StatusCode)
+}
+
+// requirePAPI skips t when the configured host does not serve the Portfolio Margin API
+func requirePAPI(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+	t.Helper()
+	papiPingOnce.Do(func() {
+		_, httpResp, err := client.PortfolioMarginAPI.GetPingV1(ctx).Execute()
+		papiUnreachable = papiUnavailable(httpResp, err)
+	})
+	if papiUnreachable == "" {
+		return
+	}
+	hint := "check network access to papi.binance.com and that your region is supported"
+	if os.Getenv("BINANCE_PMARGIN_TESTNET_SUPPORTED") == "true" {
+		hint = "PAPI has no testnet; unset BINANCE_PMARGIN_TESTNET_SUPPORTED to target papi.binance.com"
+	}
+	t.Skipf("Portfolio Margin API not available (%s): %s", papiUnreachable, hint)
+}
+
 // handleTestnetError checks if error is due to testnet limitations and skips test if so
 // Returns true if test should be skipped, false if test should continue
 func handleTestnetError(t *testing.T, err error, httpResp *http.Response, testName string) bool {
@@ -87,6 +129,47 @@ func handleTestnetError(t *testing.T, err error, httpResp *http.Response, testNa
 	return false
 }
 
+// invalidKeyIPPermissionsCode is Binance's "Invalid API-key, IP, or permissions for action" error
+const invalidKeyIPPermissionsCode = -2015
+
+// apiErrorBody returns the raw response body carried by an SDK error, if any
+func apiErrorBody(err error) []byte {
+	if bodyErr, ok := err.(interface{ Body() []byte }); ok {
+		return bodyErr.Body()
+	}
+	return nil
+}
+
+// classifyAPIError returns actionable guidance for frequently misdiagnosed API errors,
+// or an empty string when the error needs no extra explanation
+func classifyAPIError(err error) string {
+	if err == nil {
+		return ""
+	}
+	
+	var apiErr struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if json.Unmarshal(apiErrorBody(err), &apiErr) != nil {
+		return ""
+	}
+	
+	switch apiErr.Code {
+	case invalidKeyIPPermissionsCode:
+		return fmt.Sprintf("Binance rejected the API key (code %d: %s)\n"+
+			"Check the following:\n"+
+			"  1. IP allowlist: the key may be restricted to IPs that exclude this machine's egress IP "+
+			"(set BINANCE_TEST_HTTP_PROXY to route through an allowed IP)\n"+
+			"  2. Key permissions: Portfolio Margin must be enabled on the key, plus trading for order tests\n"+
+			"  3. Key type: the key must match the configured signing method (HMAC, RSA or Ed25519) "+
+			"and the target server (mainnet vs testnet)",
+			apiErr.Code, apiErr.Msg)
+	}
+	
+	return ""
+}
+
 // logAPIError safely logs API error details for debugging
 func logAPIError(t *testing.T, err error) {
 	if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	openapi "github.com/openxapi/binance-go/rest/spot"
@@ -176,6 +177,76 @@ func TestAccountCommission(t *testing.T) {
 	}
 }
 
+// parseCommissionRate parses a commission rate and checks it lies within [0,1)
+func parseCommissionRate(t *testing.T, name string, value *string) (float64, bool) {
+	t.Helper()
+	if value == nil || *value == "" {
+		t.Errorf("Expected %s in response", name)
+		return 0, false
+	}
+	
+	rate, err := strconv.ParseFloat(*value, 64)
+	if err != nil {
+		t.Errorf("%s %q is not a decimal: %v", name, *value, err)
+		return 0, false
+	}
+	if rate < 0 || rate >= 1 {
+		t.Errorf("%s %v is outside [0,1)", name, rate)
+		return rate, false
+	}
+	return rate, true
+}
+
+// TestSpotCommissionRates tests that symbol commission rates parse as valid fractional rates
+func TestSpotCommissionRates(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType < AuthTypeUSER_DATA {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "SpotCommissionRates", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				symbol := getTestSymbol()
+				resp, httpResp, err := client.SpotTradingAPI.GetAccountCommissionV3(ctx).
+					Symbol(symbol).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if handleTestnetError(t, err, httpResp, "SpotCommissionRates") {
+					return
+				}
+				if err != nil {
+					checkAPIErrorWithResponse(t, err, httpResp, "Get commission rates")
+					t.Fatalf("Failed to get commission rates: %v", err)
+				}
+				
+				if resp.StandardCommission == nil {
+					t.Fatal("Expected standardCommission in response")
+				}
+				if resp.TaxCommission == nil {
+					t.Fatal("Expected taxCommission in response")
+				}
+				if resp.Discount == nil {
+					t.Fatal("Expected discount in response")
+				}
+				
+				maker, makerOK := parseCommissionRate(t, "standardCommission.maker", resp.StandardCommission.Maker)
+				taker, takerOK := parseCommissionRate(t, "standardCommission.taker", resp.StandardCommission.Taker)
+				parseCommissionRate(t, "taxCommission.maker", resp.TaxCommission.Maker)
+				parseCommissionRate(t, "taxCommission.taker", resp.TaxCommission.Taker)
+				parseCommissionRate(t, "discount.discount", resp.Discount.Discount)
+				
+				// Standard accounts never pay more to provide liquidity than to take it
+				if makerOK && takerOK && maker > taker {
+					t.Errorf("Expected maker rate %v <= taker rate %v", maker, taker)
+				}
+				
+				t.Logf("%s commission rates - maker: %v, taker: %v", symbol, maker, taker)
+			})
+		})
+	}
+}
+
 // TestTradeFee tests the trade fee endpoint from wallet API
 func TestTradeFee(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -251,40 +322,20 @@ func TestAPIKeyPermissions(t *testing.T) {
 }
 */
 
-// TestAccountStatus tests the account status endpoint
+// TestAccountStatus tests the SAPI account status endpoint, which only exists on production
 func TestAccountStatus(t *testing.T) {
-	for _, config := range getTestConfigs() {
-		if config.AuthType < AuthTypeUSER_DATA {
-			continue
+	mainnetEndpoint(t, "AccountStatus", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		resp, httpResp, err := client.WalletAPI.GetAccountStatusV1(ctx).
+			Timestamp(generateTimestamp()).
+			RecvWindow(5000).
+			Execute()
+		if err != nil {
+			failSAPI(t, err, httpResp, "AccountStatus")
 		}
 		
-		t.Run(config.Name, func(t *testing.T) {
-			testEndpoint(t, config, "AccountStatus", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.WalletAPI.GetAccountStatusV1(ctx).
-					Timestamp(generateTimestamp()).
-					RecvWindow(5000)
-				
-				resp, httpResp, err := req.Execute()
-				if err != nil {
-					checkAPIError(t, err)
-					// This endpoint might not be available on testnet
-					if httpResp != nil && httpResp.StatusCode == 404 {
-						t.Skip("Account status endpoint not available on testnet")
-					}
-					t.Fatalf("Failed to get account status: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				// Verify response
-				if resp.Data == nil || *resp.Data == "" {
-					t.Error("Expected account status data")
-				} else {
-					t.Logf("Account status: %s", *resp.Data)
-				}
-			})
-		})
-	}
-}
\ No newline at end of file
+		if resp.Data == nil || *resp.Data == "" {
+			t.Fatal("Expected an account status string in data")
+		}
+		t.Logf("Account status: %s", *resp.Data)
+	})
+}
@@ -330,4 +330,118 @@ func TestConvertOperations(t *testing.T) {
 			t.Logf("Convert limit order created with QuoteId: %s", *resp.QuoteId)
 		}
 	})
-}
\ No newline at end of file
+}
+
+const (
+	// convertEnvVar enables the production convert quote flow
+	convertEnvVar = "BINANCE_TEST_SPOT_CONVERT"
+	// convertAcceptEnvVar additionally accepts the quote, converting real funds
+	convertAcceptEnvVar = "BINANCE_TEST_SPOT_CONVERT_ACCEPT"
+	// convertFromAmount is the BTC amount quoted, kept near the convert minimum
+	convertFromAmount = "0.0001"
+)
+
+// convertAcceptEnabled reports whether TestSpotConvertQuoteFlow accepts its quote
+func convertAcceptEnabled() bool {
+	return os.Getenv(convertAcceptEnvVar) == "true"
+}
+
+// convertQuoteFlowAuth is the auth level TestSpotConvertQuoteFlow is registered with: accepting
+// the quote converts funds, so it needs TRADE
+func convertQuoteFlowAuth() AuthType {
+	if convertAcceptEnabled() {
+		return AuthTypeTRADE
+	}
+	return AuthTypeUSER_DATA
+}
+
+// TestSpotConvertQuoteFlow requests a BTC->USDT quote on production and validates it. By default
+// the quote is left to expire using the read-only key; with BINANCE_TEST_SPOT_CONVERT_ACCEPT=true
+// the whole flow runs on the separate trade key, the quote is accepted and the resulting order
+// status is checked.
+func TestSpotConvertQuoteFlow(t *testing.T) {
+	if os.Getenv(convertEnvVar) != "true" {
+		t.Skipf("Convert quote flow disabled. Set %s=true to request a production quote", convertEnvVar)
+	}
+
+	key := mainnetReadKey
+	if convertAcceptEnabled() {
+		key = mainnetTradeKey
+	}
+
+	mainnetEndpointWithKey(t, "SpotConvertQuoteFlow", key, func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		requested := time.Now()
+		quote, httpResp, err := client.ConvertAPI.CreateConvertGetQuoteV1(ctx).
+			FromAsset("BTC").
+			ToAsset("USDT").
+			FromAmount(convertFromAmount).
+			Timestamp(generateTimestamp()).
+			RecvWindow(5000).
+			Execute()
+		if err != nil {
+			failSAPI(t, err, httpResp, "SpotConvertQuoteFlow")
+		}
+
+		if quote.QuoteId == nil || *quote.QuoteId == "" {
+			t.Fatal("Expected a quoteId")
+		}
+		if quote.Ratio == nil {
+			t.Fatal("Expected a ratio")
+		}
+		ratio, err := strconv.ParseFloat(*quote.Ratio, 64)
+		if err != nil || ratio <= 0 {
+			t.Errorf("Expected a positive ratio, got %q: %v", *quote.Ratio, err)
+		}
+		if quote.InverseRatio != nil {
+			if inverse, err := strconv.ParseFloat(*quote.InverseRatio, 64); err == nil && ratio > 0 && inverse > 0 {
+				if product := ratio * inverse; product < 0.99 || product > 1.01 {
+					t.Errorf("ratio %s and inverseRatio %s are not reciprocal (product %.4f)", *quote.Ratio, *quote.InverseRatio, product)
+				}
+			}
+		}
+		if quote.ValidTimestamp == nil {
+			t.Fatal("Expected a validTimestamp")
+		}
+		validUntil := time.UnixMilli(*quote.ValidTimestamp)
+		if !validUntil.After(requested) {
+			t.Errorf("Quote validTimestamp %s is not after the request time %s", validUntil, requested)
+		}
+		t.Logf("Quote %s: %s BTC -> %v USDT at ratio %s, valid until %s",
+			*quote.QuoteId, convertFromAmount, quote.ToAmount, *quote.Ratio, validUntil.Format(time.RFC3339))
+
+		if !convertAcceptEnabled() {
+			t.Logf("Leaving quote to expire; set %s=true to accept it and convert real funds", convertAcceptEnvVar)
+			return
+		}
+
+		accepted, httpResp, err := client.ConvertAPI.CreateConvertAcceptQuoteV1(ctx).
+			QuoteId(*quote.QuoteId).
+			Timestamp(generateTimestamp()).
+			RecvWindow(5000).
+			Execute()
+		if err != nil {
+			failSAPI(t, err, httpResp, "SpotConvertQuoteFlow accept")
+		}
+		if accepted.OrderId == nil || *accepted.OrderId == "" {
+			t.Fatal("Expected an orderId for the accepted quote")
+		}
+		t.Logf("Accepted quote %s as order %s (status %v)", *quote.QuoteId, *accepted.OrderId, accepted.OrderStatus)
+
+		rateLimiter.WaitForRateLimit()
+		status, httpResp, err := client.ConvertAPI.GetConvertOrderStatusV1(ctx).
+			OrderId(*accepted.OrderId).
+			Execute()
+		if err != nil {
+			failSAPI(t, err, httpResp, "SpotConvertQuoteFlow order status")
+		}
+		if status.OrderStatus == nil {
+			t.Fatal("Expected an orderStatus")
+		}
+		switch *status.OrderStatus {
+		case "PROCESS", "ACCEPT_SUCCESS", "SUCCESS":
+		default:
+			t.Errorf("Convert order %s ended in status %s", *accepted.OrderId, *status.OrderStatus)
+		}
+		t.Logf("Convert order %s status: %s", *accepted.OrderId, *status.OrderStatus)
+	})
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	// exchangeInfoFixture is a gzipped mainnet exchangeInfo response recorded by TestRecordExchangeInfo
+	exchangeInfoFixture = "exchange_info.json.gz"
+	// recordExchangeInfoEnvVar re-records the fixture from mainnet when set to "true"
+	recordExchangeInfoEnvVar = "BINANCE_TEST_RECORD_EXCHANGE_INFO"
+	// exchangeInfoURL serves the full mainnet exchangeInfo; the testnet one lists far fewer symbols
+	exchangeInfoURL = "https://api.binance.com/api/v3/exchangeInfo"
+	// exchangeInfoDecodeBudget bounds a single full decode, generous enough for slow CI machines
+	exchangeInfoDecodeBudget = 2 * time.Second
+)
+
+// loadExchangeInfo returns the recorded exchangeInfo body, skipping when it has not been recorded yet
+func loadExchangeInfo(tb testing.TB) []byte {
+	tb.Helper()
+	compressed, err := os.ReadFile(filepath.Join("testdata", exchangeInfoFixture))
+	if errors.Is(err, fs.ErrNotExist) {
+		tb.Skipf("testdata/%s not recorded. Set %s=true and run TestRecordExchangeInfo", exchangeInfoFixture, recordExchangeInfoEnvVar)
+	}
+	if err != nil {
+		tb.Fatalf("Failed to load %s: %v", exchangeInfoFixture, err)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		tb.Fatalf("Failed to open %s: %v", exchangeInfoFixture, err)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		tb.Fatalf("Failed to decompress %s: %v", exchangeInfoFixture, err)
+	}
+	return body
+}
+
+// recordedSymbols lists the symbol names in a raw exchangeInfo body, in response order
+func recordedSymbols(tb testing.TB, body []byte) []string {
+	tb.Helper()
+	var raw struct {
+		Symbols []struct {
+			Symbol string `json:"symbol"`
+		} `json:"symbols"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		tb.Fatalf("Recorded %s is not valid exchangeInfo JSON: %v", exchangeInfoFixture, err)
+	}
+	names := make([]string, len(raw.Symbols))
+	for i, s := range raw.Symbols {
+		names[i] = s.Symbol
+	}
+	return names
+}
+
+// TestRecordExchangeInfo re-records the fixture from the live mainnet endpoint. It is opt-in
+// so the committed response stays fixed between runs.
+func TestRecordExchangeInfo(t *testing.T) {
+	if os.Getenv(recordExchangeInfoEnvVar) != "true" {
+		t.Skipf("exchangeInfo recording disabled. Set %s=true to re-record testdata", recordExchangeInfoEnvVar)
+	}
+
+	client := &http.Client{Transport: newTransport(), Timeout: 30 * time.Second}
+	resp, err := client.Get(exchangeInfoURL)
+	if err != nil {
+		t.Fatalf("Failed to fetch exchangeInfo: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Failed to read exchangeInfo: status %d, %v", resp.StatusCode, err)
+	}
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(body); err != nil {
+		t.Fatalf("Failed to compress exchangeInfo: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to compress exchangeInfo: %v", err)
+	}
+	if err := os.MkdirAll("testdata", 0o755); err != nil {
+		t.Fatalf("Failed to create testdata: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join("testdata", exchangeInfoFixture), compressed.Bytes(), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", exchangeInfoFixture, err)
+	}
+	t.Logf("Recorded %d symbols (%d KB) into testdata/%s", len(recordedSymbols(t, body)), len(body)/1024, exchangeInfoFixture)
+}
+
+// fixtureTransport answers every request with the same JSON body, so decoding is measured without network
+type fixtureTransport []byte
+
+func (body fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// exchangeInfoDecoder returns a function that runs body through the SDK's GetExchangeInfoV3
+// decode path and reports the decoded symbol names
+func exchangeInfoDecoder(body []byte) func() ([]string, error) {
+	client, ctx := setupClient(TestConfig{Name: "Decode", AuthType: AuthTypeNONE})
+	client.GetConfig().HTTPClient = &http.Client{Transport: fixtureTransport(body)}
+
+	return func() ([]string, error) {
+		resp, _, err := client.SpotTradingAPI.GetExchangeInfoV3(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(resp.Symbols))
+		for i, symbol := range resp.Symbols {
+			names[i] = symbol.GetSymbol()
+		}
+		return names, nil
+	}
+}
+
+// TestExchangeInfoDecodeBound tests that the recorded full exchangeInfo decodes within a time
+// budget and that every recorded symbol comes back, in order
+func TestExchangeInfoDecodeBound(t *testing.T) {
+	body := loadExchangeInfo(t)
+	want := recordedSymbols(t, body)
+	decode := exchangeInfoDecoder(body)
+
+	start := time.Now()
+	got, err := decode()
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("Failed to decode exchangeInfo: %v", err)
+	}
+	if elapsed > exchangeInfoDecodeBudget {
+		t.Errorf("Decoding %d symbols took %v, budget %v", len(want), elapsed, exchangeInfoDecodeBudget)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Decoded %d symbols, recorded response has %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Symbol %d decoded as %q, recorded %q", i, got[i], want[i])
+		}
+	}
+
+	allocs := testing.AllocsPerRun(3, func() { decode() })
+	t.Logf("Decoded %d symbols (%d KB) in %v with %.0f allocs (%.0f per symbol)",
+		len(want), len(body)/1024, elapsed, allocs, allocs/float64(len(want)))
+}
+
+// BenchmarkExchangeInfoDecode measures decoding the recorded full exchangeInfo response
+func BenchmarkExchangeInfoDecode(b *testing.B) {
+	body := loadExchangeInfo(b)
+	decode := exchangeInfoDecoder(body)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := decode(); err != nil {
+			b.Fatalf("Failed to decode exchangeInfo: %v", err)
+		}
+	}
+}
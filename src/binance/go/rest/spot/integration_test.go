@@ -20,6 +20,7 @@ import (
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/spot"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // AuthType represents the type of authentication
@@ -147,6 +148,9 @@ func setupClient(config TestConfig) (*openapi.APIClient, context.Context) {
 		},
 	}
 
+	// Each client owns its transport, routed through BINANCE_TEST_HTTP_PROXY when set
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+
 	// Create client
 	client := openapi.NewAPIClient(cfg)
 	ctx := context.Background()
@@ -250,7 +254,7 @@ func loadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
 func testEndpoint(t *testing.T, config TestConfig, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
 	rateLimiter.WaitForRateLimit()
 
-	client, ctx := setupClient(config)
+	client, ctx := pooledClientFor(config)
 	
 	// Create a context with timeout for the HTTP requests
 	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
@@ -260,6 +264,17 @@ func testEndpoint(t *testing.T, config TestConfig, testName string, testFunc fun
 	testFunc(t, client, timeoutCtx)
 }
 
+// DefaultSpotSymbol is the symbol used when BINANCE_TEST_SPOT_SYMBOL is not set
+const DefaultSpotSymbol = "BTCUSDT"
+
+// getTestSymbol returns the symbol for symbol-scoped tests
+func getTestSymbol() string {
+	if symbol := os.Getenv("BINANCE_TEST_SPOT_SYMBOL"); symbol != "" {
+		return symbol
+	}
+	return DefaultSpotSymbol
+}
+
 // getCurrentPrice fetches the current price for a symbol using a public (non-authenticated) client
 func getCurrentPrice(client *openapi.APIClient, ctx context.Context, symbol string) (float64, error) {
 	// Create a new public client without authentication for this public endpoint
@@ -305,9 +320,30 @@ func checkAPIError(t *testing.T, err error) {
 	}
 }
 
-// generateTimestamp generates a timestamp for API requests
+// generateTimestamp generates a timestamp for API requests from the shared request clock
 func generateTimestamp() int64 {
-	return time.Now().UnixMilli()
+	return timestamp.Now()
+}
+
+// syncRequestClock aligns the shared request clock with the configured server, so signed requests
+// are not rejected for clock drift (-1021). The local clock is kept if the server time is unavailable.
+func syncRequestClock() {
+	client, ctx := setupClient(TestConfig{Name: "Request Clock"})
+	err := timestamp.Sync(func() (int64, error) {
+		resp, _, err := client.SpotTradingAPI.GetTimeV3(ctx).Execute()
+		if err != nil {
+			return 0, err
+		}
+		if resp.ServerTime == nil {
+			return 0, fmt.Errorf("response has no serverTime")
+		}
+		return *resp.ServerTime, nil
+	})
+	if err != nil {
+		fmt.Printf("Using the local clock for request timestamps: %v\n", err)
+		return
+	}
+	fmt.Printf("Request clock offset from server: %d ms\n", timestamp.Offset())
 }
 
 // TestFullIntegrationSuite runs all integration tests
@@ -400,22 +436,28 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Exchange Info", Function: TestExchangeInfo, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Server Time", Function: TestServerTime, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Market Depth", Function: TestMarketDepth, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Spot Depth Limits", Function: TestSpotDepthLimits, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Recent Trades", Function: TestRecentTrades, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Klines", Function: TestKlines, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Klines Time Zone", Function: TestSpotKlinesTimeZone, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Klines Range", Function: TestSpotKlinesRange, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "24hr Ticker", Function: Test24hrTicker, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Average Price", Function: TestAveragePrice, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Ping", Function: TestPing, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Agg Trades", Function: TestAggTrades, AuthRequired: AuthTypeNONE, Category: "Public"},
-		{Name: "Historical Trades", Function: TestHistoricalTrades, AuthRequired: AuthTypeNONE, Category: "Public"},
+		// Historical trades needs an API key (MARKET_DATA) and only runs keyed configs, so without one the suite skips it rather than reporting an empty pass
+		{Name: "Historical Trades", Function: TestHistoricalTrades, AuthRequired: AuthTypeUSER_DATA, Category: "Public"},
 		{Name: "Ticker 24hr", Function: TestTicker24hr, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Ticker Price", Function: TestTickerPrice, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Ticker Book", Function: TestTickerBookTicker, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Ticker Trading Day", Function: TestTickerTradingDay, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "UI Klines", Function: TestUiKlines, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Rate Limit Headers", Function: TestRateLimitHeaders, AuthRequired: AuthTypeNONE, Category: "Public"},
 		
 		// Account API Tests
 		{Name: "Account Info", Function: TestAccountInfo, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Account Commission", Function: TestAccountCommission, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
+		{Name: "Spot Commission Rates", Function: TestSpotCommissionRates, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		{Name: "Trade Fee", Function: TestTradeFee, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "API Key Permissions", Function: TestAPIKeyPermissions, AuthRequired: AuthTypeUSER_DATA, Category: "Account"}, // Commented out in account_test.go
 		{Name: "Account Status", Function: TestAccountStatus, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
@@ -423,15 +465,21 @@ func (suite *TestSuite) initializeTests() {
 		
 		// Trading API Tests
 		{Name: "Create Order", Function: TestCreateOrder, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Order Count Headers", Function: TestOrderCountHeaders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "New Order Resp Type", Function: TestNewOrderRespType, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Query Order", Function: TestQueryOrder, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Cancel Order", Function: TestCancelOrder, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "All Orders", Function: TestAllOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "My Trades", Function: TestMyTrades, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Create Order Test", Function: TestCreateOrderTest, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Open Orders", Function: TestOpenOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "Spot Open Orders", Function: TestSpotOpenOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "Spot All Orders", Function: TestSpotAllOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Delete Open Orders", Function: TestDeleteOpenOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "My Prevented Matches", Function: TestMyPreventedMatches, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Order Cancel Replace", Function: TestOrderCancelReplace, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Order Amend Keep Priority", Function: TestOrderAmendKeepPriority, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Order Fills", Function: TestOrderFills, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		
 		// OCO Trading Tests
 		{Name: "Create Order OCO", Function: TestCreateOrderOco, AuthRequired: AuthTypeTRADE, Category: "OCO"},
@@ -439,12 +487,14 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Get Order List", Function: TestGetOrderList, AuthRequired: AuthTypeUSER_DATA, Category: "OCO"},
 		{Name: "Get Open Order List", Function: TestGetOpenOrderList, AuthRequired: AuthTypeUSER_DATA, Category: "OCO"},
 		{Name: "Get All Order List", Function: TestGetAllOrderList, AuthRequired: AuthTypeUSER_DATA, Category: "OCO"},
+		{Name: "Order List Queries", Function: TestOrderListQueries, AuthRequired: AuthTypeUSER_DATA, Category: "OCO"},
 		{Name: "Create Order List OTO", Function: TestCreateOrderListOto, AuthRequired: AuthTypeTRADE, Category: "OCO"},
 		{Name: "Create Order List OTOCO", Function: TestCreateOrderListOtoco, AuthRequired: AuthTypeTRADE, Category: "OCO"},
 		
 		// SOR Trading Tests
 		{Name: "Create SOR Order", Function: TestCreateSorOrder, AuthRequired: AuthTypeTRADE, Category: "SOR"},
 		{Name: "Create SOR Order Test", Function: TestCreateSorOrderTest, AuthRequired: AuthTypeTRADE, Category: "SOR"},
+		{Name: "Create SOR Order Test Min Notional", Function: TestCreateSorOrderTestMinNotional, AuthRequired: AuthTypeTRADE, Category: "SOR"},
 		{Name: "Get My Allocations", Function: TestGetMyAllocations, AuthRequired: AuthTypeUSER_DATA, Category: "SOR"},
 		
 		// Wallet API Tests
@@ -528,6 +578,7 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Convert Orders", Function: TestConvertOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Convert"},
 		{Name: "Convert Limit Orders", Function: TestConvertLimitOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Convert"},
 		{Name: "Convert Operations", Function: TestConvertOperations, AuthRequired: AuthTypeTRADE, Category: "Convert"},
+		{Name: "Spot Convert Quote Flow", Function: TestSpotConvertQuoteFlow, AuthRequired: convertQuoteFlowAuth(), Category: "Convert"},
 		
 		// Crypto Loan Tests
 		{Name: "Crypto Loan Info", Function: TestCryptoLoanInfo, AuthRequired: AuthTypeUSER_DATA, Category: "CryptoLoan"},
@@ -607,7 +658,7 @@ func getTestClient(t *testing.T) *openapi.APIClient {
 	configs := getTestConfigs()
 	for _, config := range configs {
 		if config.AuthType == AuthTypeTRADE {
-			client, _ := setupClient(config)
+			client, _ := pooledClientFor(config)
 			return client
 		}
 	}
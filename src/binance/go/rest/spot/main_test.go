@@ -15,6 +15,15 @@ func TestMain(m *testing.M) {
 	fmt.Println("Using testnet server by default")
 	fmt.Println()
 
+	// Fail fast on an unusable proxy instead of timing out every request
+	if err := configureHTTPProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Align request timestamps with the server clock before any signed request
+	syncRequestClock()
+
 	// Run tests
 	code := m.Run()
 
@@ -352,7 +352,189 @@ func TestGetAllOrderList(t *testing.T) {
 	}
 }
 
-// TestCreateOrderListOto tests creating an OTO (One-Triggers-Other) order
+// orderListLiveReason explains why a live OCO cannot be placed for config, or returns "" if it can
+func orderListLiveReason(config TestConfig) string {
+	if config.AuthType != AuthTypeTRADE {
+		return "config has no TRADE permission"
+	}
+	statuses, err := symbolStatuses()
+	if err != nil {
+		return fmt.Sprintf("could not check market status: %v", err)
+	}
+	return nonTradingReason("BTCUSDT", statuses)
+}
+
+// TestOrderListQueries tests the order-list read endpoints (allOrderList, openOrderList and
+// orderList by ID). When trading is possible an OCO is placed first and must appear in all
+// three as EXECUTING with both legs; otherwise only existing lists are read.
+func TestOrderListQueries(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType < AuthTypeUSER_DATA {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "OrderListQueries", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				var createdId int64
+				
+				if reason := orderListLiveReason(config); reason != "" {
+					t.Logf("Skipping live OCO placement: %s", reason)
+				} else {
+					price, err := getCurrentPrice(client, ctx, "BTCUSDT")
+					if err != nil {
+						t.Fatalf("Failed to get current price: %v", err)
+					}
+					
+					rateLimiter.WaitForRateLimit()
+					createResp, httpResp, err := client.SpotTradingAPI.CreateOrderListOcoV3(ctx).
+						Symbol("BTCUSDT").
+						Side("SELL").
+						Quantity("0.0001").
+						AboveType("LIMIT_MAKER").
+						AbovePrice(fmt.Sprintf("%.2f", price*1.05)).
+						BelowType("STOP_LOSS_LIMIT").
+						BelowPrice(fmt.Sprintf("%.2f", price*0.94)).
+						BelowStopPrice(fmt.Sprintf("%.2f", price*0.95)).
+						BelowTimeInForce("GTC").
+						Timestamp(generateTimestamp()).
+						RecvWindow(5000).
+						Execute()
+					if err != nil {
+						if handleTestnetError(t, err, httpResp, "OrderListQueries") {
+							return
+						}
+						checkAPIError(t, err)
+						t.Fatalf("Failed to create OCO for order list queries: %v", err)
+					}
+					if createResp.OrderListId == nil || *createResp.OrderListId == 0 {
+						t.Fatal("Expected order list ID")
+					}
+					createdId = *createResp.OrderListId
+					
+					// Always cancel the list, even if an assertion below fails
+					defer func() {
+						rateLimiter.WaitForRateLimit()
+						_, _, cancelErr := client.SpotTradingAPI.DeleteOrderListV3(ctx).
+							Symbol("BTCUSDT").
+							OrderListId(createdId).
+							Timestamp(generateTimestamp()).
+							RecvWindow(5000).
+							Execute()
+						if cancelErr != nil {
+							t.Logf("Warning: Failed to cancel order list %d: %v", createdId, cancelErr)
+						}
+					}()
+				}
+				
+				// allOrderList: start at the created list so it is on the first page
+				rateLimiter.WaitForRateLimit()
+				allReq := client.SpotTradingAPI.GetAllOrderListV3(ctx).
+					Limit(10).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000)
+				if createdId != 0 {
+					allReq = allReq.FromId(createdId)
+				}
+				allResp, _, err := allReq.Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get all order lists: %v", err)
+				}
+				
+				foundInAll := false
+				for _, orderList := range allResp {
+					if orderList.OrderListId == nil || orderList.ContingencyType == nil || orderList.ListStatusType == nil {
+						t.Errorf("allOrderList entry missing orderListId, contingencyType or listStatusType: %+v", orderList)
+						continue
+					}
+					if *orderList.OrderListId != createdId {
+						continue
+					}
+					foundInAll = true
+					if orderList.ListOrderStatus == nil || *orderList.ListOrderStatus != "EXECUTING" {
+						t.Errorf("allOrderList: expected listOrderStatus EXECUTING for %d, got %v", createdId, orderList.ListOrderStatus)
+					}
+					if len(orderList.Orders) != 2 {
+						t.Errorf("allOrderList: expected 2 legs for %d, got %d", createdId, len(orderList.Orders))
+					}
+				}
+				if createdId != 0 && !foundInAll {
+					t.Errorf("Created order list %d missing from allOrderList", createdId)
+				}
+				t.Logf("allOrderList returned %d lists", len(allResp))
+				
+				// openOrderList
+				rateLimiter.WaitForRateLimit()
+				openResp, _, err := client.SpotTradingAPI.GetOpenOrderListV3(ctx).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get open order lists: %v", err)
+				}
+				
+				foundInOpen := false
+				for _, orderList := range openResp {
+					if orderList.OrderListId == nil || *orderList.OrderListId != createdId {
+						continue
+					}
+					foundInOpen = true
+					if orderList.ListOrderStatus == nil || *orderList.ListOrderStatus != "EXECUTING" {
+						t.Errorf("openOrderList: expected listOrderStatus EXECUTING for %d, got %v", createdId, orderList.ListOrderStatus)
+					}
+					if len(orderList.Orders) != 2 {
+						t.Errorf("openOrderList: expected 2 legs for %d, got %d", createdId, len(orderList.Orders))
+					}
+				}
+				if createdId != 0 && !foundInOpen {
+					t.Errorf("Created order list %d missing from openOrderList", createdId)
+				}
+				t.Logf("openOrderList returned %d lists", len(openResp))
+				
+				// orderList by ID: the created list, or any existing one
+				queryId := createdId
+				if queryId == 0 && len(allResp) > 0 && allResp[0].OrderListId != nil {
+					queryId = *allResp[0].OrderListId
+				}
+				if queryId == 0 {
+					t.Log("No order lists available to query by ID")
+					return
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				listResp, _, err := client.SpotTradingAPI.GetOrderListV3(ctx).
+					OrderListId(queryId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get order list %d: %v", queryId, err)
+				}
+				
+				if listResp.OrderListId == nil || *listResp.OrderListId != queryId {
+					t.Errorf("orderList: expected orderListId %d, got %v", queryId, listResp.OrderListId)
+				}
+				if listResp.ListOrderStatus == nil || *listResp.ListOrderStatus == "" {
+					t.Error("orderList: expected listOrderStatus")
+				}
+				if createdId != 0 {
+					if listResp.ListOrderStatus != nil && *listResp.ListOrderStatus != "EXECUTING" {
+						t.Errorf("orderList: expected listOrderStatus EXECUTING, got %s", *listResp.ListOrderStatus)
+					}
+					if len(listResp.Orders) != 2 {
+						t.Errorf("orderList: expected 2 legs, got %d", len(listResp.Orders))
+					}
+				}
+				
+				t.Logf("orderList %d has %d legs", queryId, len(listResp.Orders))
+			})
+		})
+	}
+}
+
+// TestCreateOrderListOto tests creating an OTO order list, its leg structure and that it persists until canceled
 func TestCreateOrderListOto(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeTRADE {
@@ -361,22 +543,19 @@ func TestCreateOrderListOto(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "CreateOrderListOto", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				// Get current price
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
 					t.Fatalf("Failed to get current price: %v", err)
 				}
 				
-				// Set working order price below market
-				workingPrice := price * 0.95
-				workingPriceStr := fmt.Sprintf("%.2f", workingPrice)
-				
-				// Set pending order price
-				pendingPrice := price * 0.90
-				pendingPriceStr := fmt.Sprintf("%.2f", pendingPrice)
+				// Working BUY well below market so it stays unfilled and the pending leg is never triggered
+				workingPriceStr := fmt.Sprintf("%.2f", price*0.95)
+				pendingPriceStr := fmt.Sprintf("%.2f", price*1.05)
+				listClientOrderId := fmt.Sprintf("oto_%d", generateTimestamp())
 				
 				req := client.SpotTradingAPI.CreateOrderListOtoV3(ctx).
 					Symbol("BTCUSDT").
+					ListClientOrderId(listClientOrderId).
 					WorkingType("LIMIT").
 					WorkingSide("BUY").
 					WorkingPrice(workingPriceStr).
@@ -395,42 +574,80 @@ func TestCreateOrderListOto(t *testing.T) {
 					checkAPIError(t, err)
 					// Never skip 400 - these are bad requests that need fixing
 					// If OTO returns 400, it indicates a real API issue that needs investigation
-					t.Fatalf("Failed to create OTO order: %v", err)
+					t.Fatalf("Failed to create OTO order list: %v", err)
 				}
 				
 				if httpResp.StatusCode != 200 {
 					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
 				}
 				
-				// Verify response
 				if resp.OrderListId == nil || *resp.OrderListId == 0 {
-					t.Error("Expected order list ID")
+					t.Fatal("Expected order list ID")
 				}
+				orderListId := *resp.OrderListId
 				
-				if resp.ContingencyType == nil || *resp.ContingencyType != "OTO" {
-					t.Errorf("Expected contingency type OTO, got %v", resp.ContingencyType)
-				}
-				
-				// Cancel the order list
-				if resp.OrderListId != nil {
+				// Always cancel the list, even if an assertion below fails
+				defer func() {
 					rateLimiter.WaitForRateLimit()
-					cancelReq := client.SpotTradingAPI.DeleteOrderListV3(ctx).
+					_, _, cancelErr := client.SpotTradingAPI.DeleteOrderListV3(ctx).
 						Symbol("BTCUSDT").
-						OrderListId(*resp.OrderListId).
+						OrderListId(orderListId).
 						Timestamp(generateTimestamp()).
-						RecvWindow(5000)
-					
-					_, _, cancelErr := cancelReq.Execute()
+						RecvWindow(5000).
+						Execute()
 					if cancelErr != nil {
-						t.Logf("Warning: Failed to cancel OTO order: %v", cancelErr)
+						t.Logf("Warning: Failed to cancel OTO order list %d: %v", orderListId, cancelErr)
+					}
+				}()
+				
+				if resp.ContingencyType == nil || *resp.ContingencyType != "OTO" {
+					t.Errorf("Expected contingency type OTO, got %v", resp.ContingencyType)
+				}
+				
+				if resp.ListClientOrderId == nil || *resp.ListClientOrderId != listClientOrderId {
+					t.Errorf("Expected listClientOrderId %s, got %v", listClientOrderId, resp.ListClientOrderId)
+				}
+				
+				// OTO has exactly two legs: the working order and the pending order
+				if len(resp.Orders) != 2 {
+					t.Errorf("Expected 2 orders in OTO response, got %d", len(resp.Orders))
+				}
+				if len(resp.OrderReports) != 2 {
+					t.Errorf("Expected 2 order reports in OTO response, got %d", len(resp.OrderReports))
+				}
+				
+				for i, report := range resp.OrderReports {
+					if report.OrderListId == nil || *report.OrderListId != orderListId {
+						t.Errorf("Order report %d: expected orderListId %d, got %v", i, orderListId, report.OrderListId)
 					}
 				}
+				
+				// The list must still be queryable since the working order is resting
+				rateLimiter.WaitForRateLimit()
+				listResp, _, err := client.SpotTradingAPI.GetOrderListV3(ctx).
+					OrderListId(orderListId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to query OTO order list: %v", err)
+				}
+				
+				if listResp.ListClientOrderId == nil || *listResp.ListClientOrderId != listClientOrderId {
+					t.Errorf("Queried list: expected listClientOrderId %s, got %v", listClientOrderId, listResp.ListClientOrderId)
+				}
+				if len(listResp.Orders) != 2 {
+					t.Errorf("Queried list: expected 2 orders, got %d", len(listResp.Orders))
+				}
+				
+				t.Logf("OTO order list %d (%s) has %d legs", orderListId, listClientOrderId, len(listResp.Orders))
 			})
 		})
 	}
 }
 
-// TestCreateOrderListOtoco tests creating an OTOCO (One-Triggers-One-Cancels-Other) order
+// TestCreateOrderListOtoco tests creating an OTOCO order list, its leg structure and that it persists until canceled
 func TestCreateOrderListOtoco(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeTRADE {
@@ -439,28 +656,21 @@ func TestCreateOrderListOtoco(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "CreateOrderListOtoco", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				// Get current price
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
 					t.Fatalf("Failed to get current price: %v", err)
 				}
 				
-				// Set working order price below market
-				workingPrice := price * 0.95
-				workingPriceStr := fmt.Sprintf("%.2f", workingPrice)
-				
-				// Set pending limit and stop prices
-				pendingLimitPrice := price * 1.05
-				pendingLimitPriceStr := fmt.Sprintf("%.2f", pendingLimitPrice)
-				
-				pendingStopPrice := price * 0.90
-				pendingStopPriceStr := fmt.Sprintf("%.2f", pendingStopPrice)
-				
-				pendingStopLimitPrice := price * 0.89
-				pendingStopLimitPriceStr := fmt.Sprintf("%.2f", pendingStopLimitPrice)
+				// Working BUY well below market so the OCO pair is never triggered
+				workingPriceStr := fmt.Sprintf("%.2f", price*0.95)
+				pendingAbovePriceStr := fmt.Sprintf("%.2f", price*1.05)
+				pendingBelowStopPriceStr := fmt.Sprintf("%.2f", price*0.90)
+				pendingBelowPriceStr := fmt.Sprintf("%.2f", price*0.89)
+				listClientOrderId := fmt.Sprintf("otoco_%d", generateTimestamp())
 				
 				req := client.SpotTradingAPI.CreateOrderListOtocoV3(ctx).
 					Symbol("BTCUSDT").
+					ListClientOrderId(listClientOrderId).
 					WorkingType("LIMIT").
 					WorkingSide("BUY").
 					WorkingPrice(workingPriceStr).
@@ -469,10 +679,10 @@ func TestCreateOrderListOtoco(t *testing.T) {
 					PendingSide("SELL").
 					PendingQuantity("0.0001").
 					PendingAboveType("LIMIT_MAKER").
-					PendingAbovePrice(pendingLimitPriceStr).
+					PendingAbovePrice(pendingAbovePriceStr).
 					PendingBelowType("STOP_LOSS_LIMIT").
-					PendingBelowStopPrice(pendingStopPriceStr).
-					PendingBelowPrice(pendingStopLimitPriceStr).
+					PendingBelowStopPrice(pendingBelowStopPriceStr).
+					PendingBelowPrice(pendingBelowPriceStr).
 					PendingBelowTimeInForce("GTC").
 					Timestamp(generateTimestamp()).
 					RecvWindow(5000)
@@ -482,37 +692,75 @@ func TestCreateOrderListOtoco(t *testing.T) {
 					checkAPIError(t, err)
 					// Never skip 400 - these are bad requests that need fixing
 					// If OTOCO returns 400, it indicates a real API issue that needs investigation
-					t.Fatalf("Failed to create OTOCO order: %v", err)
+					t.Fatalf("Failed to create OTOCO order list: %v", err)
 				}
 				
 				if httpResp.StatusCode != 200 {
 					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
 				}
 				
-				// Verify response
 				if resp.OrderListId == nil || *resp.OrderListId == 0 {
-					t.Error("Expected order list ID")
+					t.Fatal("Expected order list ID")
 				}
+				orderListId := *resp.OrderListId
 				
-				if resp.ContingencyType == nil || *resp.ContingencyType != "OTO" {
-					t.Errorf("Expected contingency type OTO, got %v", resp.ContingencyType)
-				}
-				
-				// Cancel the order list
-				if resp.OrderListId != nil {
+				// Always cancel the list, even if an assertion below fails
+				defer func() {
 					rateLimiter.WaitForRateLimit()
-					cancelReq := client.SpotTradingAPI.DeleteOrderListV3(ctx).
+					_, _, cancelErr := client.SpotTradingAPI.DeleteOrderListV3(ctx).
 						Symbol("BTCUSDT").
-						OrderListId(*resp.OrderListId).
+						OrderListId(orderListId).
 						Timestamp(generateTimestamp()).
-						RecvWindow(5000)
-					
-					_, _, cancelErr := cancelReq.Execute()
+						RecvWindow(5000).
+						Execute()
 					if cancelErr != nil {
-						t.Logf("Warning: Failed to cancel OTOCO order: %v", cancelErr)
+						t.Logf("Warning: Failed to cancel OTOCO order list %d: %v", orderListId, cancelErr)
 					}
+				}()
+				
+				// Binance reports OTOCO lists with contingencyType OTO
+				if resp.ContingencyType == nil || *resp.ContingencyType != "OTO" {
+					t.Errorf("Expected contingency type OTO, got %v", resp.ContingencyType)
+				}
+				
+				if resp.ListClientOrderId == nil || *resp.ListClientOrderId != listClientOrderId {
+					t.Errorf("Expected listClientOrderId %s, got %v", listClientOrderId, resp.ListClientOrderId)
 				}
+				
+				// OTOCO has three legs: the working order plus the pending above/below pair
+				if len(resp.Orders) != 3 {
+					t.Errorf("Expected 3 orders in OTOCO response, got %d", len(resp.Orders))
+				}
+				if len(resp.OrderReports) != 3 {
+					t.Errorf("Expected 3 order reports in OTOCO response, got %d", len(resp.OrderReports))
+				}
+				
+				for i, report := range resp.OrderReports {
+					if report.OrderListId == nil || *report.OrderListId != orderListId {
+						t.Errorf("Order report %d: expected orderListId %d, got %v", i, orderListId, report.OrderListId)
+					}
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				listResp, _, err := client.SpotTradingAPI.GetOrderListV3(ctx).
+					OrderListId(orderListId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to query OTOCO order list: %v", err)
+				}
+				
+				if listResp.ListClientOrderId == nil || *listResp.ListClientOrderId != listClientOrderId {
+					t.Errorf("Queried list: expected listClientOrderId %s, got %v", listClientOrderId, listResp.ListClientOrderId)
+				}
+				if len(listResp.Orders) != 3 {
+					t.Errorf("Queried list: expected 3 orders, got %d", len(listResp.Orders))
+				}
+				
+				t.Logf("OTOCO order list %d (%s) has %d legs", orderListId, listClientOrderId, len(listResp.Orders))
 			})
 		})
 	}
-}
\ No newline at end of file
+}
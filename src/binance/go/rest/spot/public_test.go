@@ -2,10 +2,16 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/spot"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/tickerstats"
 )
 
 // TestExchangeInfo tests the exchange info endpoint
@@ -153,6 +159,80 @@ func TestMarketDepth(t *testing.T) {
 	}
 }
 
+// depthLimitWeights lists depth limits with the documented request weight of their bucket:
+// 1-100 costs 5, 101-500 costs 25, 501-1000 costs 50 and 1001-5000 costs 250
+var depthLimitWeights = []struct {
+	limit  int32
+	weight int
+}{
+	{5, 5},
+	{100, 5},
+	{1000, 50},
+	{5000, 250},
+}
+
+// TestSpotDepthLimits tests that depth respects each limit, that lastUpdateId never goes
+// backwards across sequential calls and that each call is charged its documented weight
+func TestSpotDepthLimits(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "SpotDepthLimits", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				// A ping gives a baseline for the cumulative used-weight counter
+				_, pingHttpResp, err := client.SpotTradingAPI.GetPingV3(ctx).Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to ping: %v", err)
+				}
+				prevWeight, prevOk := usedWeight(pingHttpResp)
+				
+				var lastUpdateId int64
+				for _, tc := range depthLimitWeights {
+					rateLimiter.WaitForRateLimit()
+					resp, httpResp, err := client.SpotTradingAPI.GetDepthV3(ctx).Symbol("BTCUSDT").Limit(tc.limit).Execute()
+					if err != nil {
+						checkAPIError(t, err)
+						t.Fatalf("Failed to get market depth with limit %d: %v", tc.limit, err)
+					}
+					
+					if len(resp.Bids) == 0 || len(resp.Asks) == 0 {
+						t.Errorf("limit=%d: expected bids and asks, got %d/%d", tc.limit, len(resp.Bids), len(resp.Asks))
+					}
+					if len(resp.Bids) > int(tc.limit) || len(resp.Asks) > int(tc.limit) {
+						t.Errorf("limit=%d: got %d bids and %d asks, more than requested", tc.limit, len(resp.Bids), len(resp.Asks))
+					}
+					
+					if resp.LastUpdateId == nil {
+						t.Fatalf("limit=%d: expected lastUpdateId in response", tc.limit)
+					}
+					if *resp.LastUpdateId < lastUpdateId {
+						t.Errorf("limit=%d: lastUpdateId went backwards from %d to %d", tc.limit, lastUpdateId, *resp.LastUpdateId)
+					}
+					lastUpdateId = *resp.LastUpdateId
+					
+					// Other requests from this IP only add to the counter, so the call must have
+					// cost at least its documented weight; a drop means the minute window reset
+					weight, ok := usedWeight(httpResp)
+					switch {
+					case !ok:
+						t.Errorf("limit=%d: expected a %s header", tc.limit, usedWeightHeader)
+					case prevOk && weight >= prevWeight && weight-prevWeight < tc.weight:
+						t.Errorf("limit=%d: %s grew by %d, documented weight is %d",
+							tc.limit, usedWeightHeader, weight-prevWeight, tc.weight)
+					}
+					prevWeight, prevOk = weight, ok
+					
+					t.Logf("limit=%d: %d bids, %d asks, lastUpdateId=%d, documented weight %d",
+						tc.limit, len(resp.Bids), len(resp.Asks), *resp.LastUpdateId, tc.weight)
+				}
+			})
+		})
+	}
+}
+
 // TestRecentTrades tests the recent trades endpoint
 func TestRecentTrades(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -248,6 +328,215 @@ func TestKlines(t *testing.T) {
 	}
 }
 
+// klinesTimeZone is the offset TestSpotKlinesTimeZone requests; daily candles should open at its midnight
+const klinesTimeZone = "+08:00"
+
+// klineOpenTimes returns the open time (ms) of each kline, decoding through JSON so it
+// does not depend on how the SDK types the mixed number/string kline array
+func klineOpenTimes(klines interface{}) ([]int64, error) {
+	raw, err := json.Marshal(klines)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]json.RawMessage
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, err
+	}
+	openTimes := make([]int64, 0, len(rows))
+	for i, row := range rows {
+		if len(row) == 0 {
+			return nil, fmt.Errorf("kline %d is empty", i)
+		}
+		var openTime int64
+		if err := json.Unmarshal(row[0], &openTime); err != nil {
+			return nil, fmt.Errorf("kline %d open time %s: %w", i, row[0], err)
+		}
+		openTimes = append(openTimes, openTime)
+	}
+	return openTimes, nil
+}
+
+// TestSpotKlinesTimeZone tests that the timeZone parameter moves daily candle boundaries
+// from UTC midnight to midnight in the requested offset
+func TestSpotKlinesTimeZone(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "KlinesTimeZone", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				utcKlines, _, err := client.SpotTradingAPI.GetKlinesV3(ctx).
+					Symbol("BTCUSDT").
+					Interval("1d").
+					Limit(5).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get UTC klines: %v", err)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				zonedKlines, _, err := client.SpotTradingAPI.GetKlinesV3(ctx).
+					Symbol("BTCUSDT").
+					Interval("1d").
+					Limit(5).
+					TimeZone(klinesTimeZone).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get klines with timeZone %s: %v", klinesTimeZone, err)
+				}
+				
+				utcOpenTimes, err := klineOpenTimes(utcKlines)
+				if err != nil {
+					t.Fatalf("Failed to read UTC kline open times: %v", err)
+				}
+				zonedOpenTimes, err := klineOpenTimes(zonedKlines)
+				if err != nil {
+					t.Fatalf("Failed to read %s kline open times: %v", klinesTimeZone, err)
+				}
+				if len(utcOpenTimes) == 0 || len(zonedOpenTimes) == 0 {
+					t.Fatalf("Expected daily klines, got %d UTC and %d zoned", len(utcOpenTimes), len(zonedOpenTimes))
+				}
+				
+				offset, err := time.Parse("-07:00", klinesTimeZone)
+				if err != nil {
+					t.Fatalf("Invalid timeZone %q: %v", klinesTimeZone, err)
+				}
+				_, offsetSeconds := offset.Zone()
+				zone := time.FixedZone(klinesTimeZone, offsetSeconds)
+				
+				for _, openTime := range utcOpenTimes {
+					if open := time.UnixMilli(openTime).UTC(); !open.Equal(open.Truncate(24 * time.Hour)) {
+						t.Errorf("UTC daily kline opens at %s, not UTC midnight", open.Format(time.RFC3339))
+					}
+				}
+				for _, openTime := range zonedOpenTimes {
+					open := time.UnixMilli(openTime).In(zone)
+					if open.Hour() != 0 || open.Minute() != 0 || open.Second() != 0 {
+						t.Errorf("Daily kline with timeZone %s opens at %s, not midnight in that zone", klinesTimeZone, open.Format(time.RFC3339))
+					}
+				}
+				
+				// The offset must actually move the boundaries
+				shift := time.Duration(utcOpenTimes[len(utcOpenTimes)-1]-zonedOpenTimes[len(zonedOpenTimes)-1]) * time.Millisecond
+				if shift%(24*time.Hour) == 0 {
+					t.Errorf("timeZone %s did not shift the daily boundaries (latest UTC open %d, zoned open %d)",
+						klinesTimeZone, utcOpenTimes[len(utcOpenTimes)-1], zonedOpenTimes[len(zonedOpenTimes)-1])
+				}
+				
+				t.Logf("Latest daily open: UTC %s, %s %s",
+					time.UnixMilli(utcOpenTimes[len(utcOpenTimes)-1]).UTC().Format(time.RFC3339),
+					klinesTimeZone, time.UnixMilli(zonedOpenTimes[len(zonedOpenTimes)-1]).In(zone).Format(time.RFC3339))
+			})
+		})
+	}
+}
+
+const (
+	// klinesRangeInterval and klinesRangeStep are the candle interval TestSpotKlinesRange pages through
+	klinesRangeInterval = "15m"
+	klinesRangeStep     = 15 * time.Minute
+	// klinesRangeSpan is the requested history; with klinesRangePageLimit it needs several pages
+	klinesRangeSpan = 3 * 24 * time.Hour
+	// klinesRangePageLimit is deliberately below the 1000 maximum so the range is paged
+	klinesRangePageLimit = 100
+	// klinesRangeMaxPages caps the requests made so a paging bug cannot exhaust request weight
+	klinesRangeMaxPages = 10
+)
+
+// TestSpotKlinesRange tests paging klines across a multi-day startTime/endTime range by
+// advancing startTime past the last open time, and checks the pages cover it without gaps or overlaps
+func TestSpotKlinesRange(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "KlinesRange", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				// End at the last closed candle so the in-progress one does not change the count
+				end := time.Now().UTC().Truncate(klinesRangeStep)
+				start := end.Add(-klinesRangeSpan)
+				endTime := end.UnixMilli() - 1
+				expected := int(klinesRangeSpan / klinesRangeStep)
+				
+				var openTimes []int64
+				pages := 0
+				for startTime := start.UnixMilli(); startTime <= endTime; {
+					if pages == klinesRangeMaxPages {
+						t.Fatalf("Range not covered after %d pages (%d of %d candles); paging is not advancing",
+							pages, len(openTimes), expected)
+					}
+					if pages > 0 {
+						rateLimiter.WaitForRateLimit()
+					}
+					
+					klines, _, err := client.SpotTradingAPI.GetKlinesV3(ctx).
+						Symbol("BTCUSDT").
+						Interval(klinesRangeInterval).
+						StartTime(startTime).
+						EndTime(endTime).
+						Limit(klinesRangePageLimit).
+						Execute()
+					if err != nil {
+						checkAPIError(t, err)
+						t.Fatalf("Failed to get klines page %d: %v", pages+1, err)
+					}
+					pages++
+					
+					page, err := klineOpenTimes(klines)
+					if err != nil {
+						t.Fatalf("Failed to read open times on page %d: %v", pages, err)
+					}
+					if len(page) > klinesRangePageLimit {
+						t.Errorf("Page %d returned %d klines, limit %d", pages, len(page), klinesRangePageLimit)
+					}
+					if len(page) == 0 {
+						break
+					}
+					if page[0] < startTime || page[len(page)-1] > endTime {
+						t.Errorf("Page %d spans %d-%d, outside requested range %d-%d",
+							pages, page[0], page[len(page)-1], startTime, endTime)
+					}
+					
+					openTimes = append(openTimes, page...)
+					startTime = page[len(page)-1] + 1
+					if len(page) < klinesRangePageLimit {
+						break
+					}
+				}
+				
+				if len(openTimes) == 0 {
+					t.Fatalf("No klines returned for %s to %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+				}
+				if openTimes[0] != start.UnixMilli() {
+					t.Errorf("First kline opens at %s, want range start %s",
+						time.UnixMilli(openTimes[0]).UTC().Format(time.RFC3339), start.Format(time.RFC3339))
+				}
+				
+				step := klinesRangeStep.Milliseconds()
+				for i := 1; i < len(openTimes); i++ {
+					switch delta := openTimes[i] - openTimes[i-1]; {
+					case delta <= 0:
+						t.Errorf("Overlap: kline %d opens at %d, not after the previous %d", i, openTimes[i], openTimes[i-1])
+					case delta != step:
+						t.Errorf("Gap: kline %d opens %v after the previous, want %v", i,
+							time.Duration(delta)*time.Millisecond, klinesRangeStep)
+					}
+				}
+				if len(openTimes) != expected {
+					t.Errorf("Fetched %d %s klines for %v, want %d", len(openTimes), klinesRangeInterval, klinesRangeSpan, expected)
+				}
+				
+				t.Logf("Fetched %d %s klines from %s to %s in %d pages",
+					len(openTimes), klinesRangeInterval, start.Format(time.RFC3339), end.Format(time.RFC3339), pages)
+			})
+		})
+	}
+}
+
 // Test24hrTicker tests the 24hr ticker statistics endpoint
 func Test24hrTicker(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -287,6 +576,9 @@ func Test24hrTicker(t *testing.T) {
 					if ticker.Volume == nil || *ticker.Volume == "" {
 						t.Error("Expected volume")
 					}
+					for _, problem := range tickerstats.Violations(ticker.OpenPrice, ticker.LastPrice, ticker.HighPrice, ticker.LowPrice, ticker.WeightedAvgPrice, ticker.PriceChangePercent) {
+						t.Errorf("BTCUSDT 24hr ticker: %s", problem)
+					}
 				} else if resp.ArrayOfSpotGetTicker24hrV3RespItem != nil && len(*resp.ArrayOfSpotGetTicker24hrV3RespItem) > 0 {
 					tickers := *resp.ArrayOfSpotGetTicker24hrV3RespItem
 					if len(tickers) != 1 {
@@ -296,6 +588,9 @@ func Test24hrTicker(t *testing.T) {
 					if ticker.Symbol == nil || *ticker.Symbol != "BTCUSDT" {
 						t.Errorf("Expected symbol BTCUSDT")
 					}
+					for _, problem := range tickerstats.Violations(ticker.OpenPrice, ticker.LastPrice, ticker.HighPrice, ticker.LowPrice, ticker.WeightedAvgPrice, ticker.PriceChangePercent) {
+						t.Errorf("BTCUSDT 24hr ticker: %s", problem)
+					}
 				} else {
 					t.Error("Unexpected response format")
 				}
@@ -304,7 +599,10 @@ func Test24hrTicker(t *testing.T) {
 	}
 }
 
-// TestAveragePrice tests the average price endpoint
+// avgPriceBand is the maximum relative gap tolerated between the average and last price
+const avgPriceBand = 0.10
+
+// TestAveragePrice tests the average price endpoint against the latest ticker price
 func TestAveragePrice(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeNONE {
@@ -325,23 +623,40 @@ func TestAveragePrice(t *testing.T) {
 				}
 				
 				// Verify response
-				if resp.Mins == nil || *resp.Mins == 0 {
-					t.Error("Expected mins in response")
+				if resp.Mins == nil || *resp.Mins <= 0 {
+					t.Errorf("Expected positive mins, got %v", resp.Mins)
 				}
-				
 				if resp.Price == nil || *resp.Price == "" {
-					t.Error("Expected price in response")
+					t.Fatal("Expected price in response")
+				}
+				avgPrice, err := strconv.ParseFloat(*resp.Price, 64)
+				if err != nil {
+					t.Fatalf("Average price %q is not a number: %v", *resp.Price, err)
+				}
+				if avgPrice <= 0 {
+					t.Fatalf("Expected positive average price, got %v", avgPrice)
 				}
 				
-				// Verify price is a valid number
-				if resp.Price != nil {
-					price, err := getCurrentPrice(client, ctx, "BTCUSDT")
-					if err != nil {
-						t.Errorf("Failed to parse price: %v", err)
-					}
-					if price <= 0 {
-						t.Error("Expected positive price")
-					}
+				tickerResp, _, err := client.SpotTradingAPI.GetTickerPriceV3(ctx).Symbol("BTCUSDT").Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get ticker price: %v", err)
+				}
+				ticker := tickerResp.SpotGetTickerPriceV3RespItem
+				if ticker == nil || ticker.Price == nil {
+					t.Fatal("Expected single ticker price in response")
+				}
+				lastPrice, err := strconv.ParseFloat(*ticker.Price, 64)
+				if err != nil {
+					t.Fatalf("Ticker price %q is not a number: %v", *ticker.Price, err)
+				}
+				
+				t.Logf("BTCUSDT average price over %d mins: %v, last price: %v", *resp.Mins, avgPrice, lastPrice)
+				
+				// The average covers only the last few minutes, so it should track the last price closely
+				if gap := math.Abs(avgPrice-lastPrice) / lastPrice; gap > avgPriceBand {
+					t.Errorf("Average price %v is %.2f%% away from last price %v (band %.0f%%)",
+						avgPrice, gap*100, lastPrice, avgPriceBand*100)
 				}
 			})
 		})
@@ -428,41 +743,62 @@ func TestAggTrades(t *testing.T) {
 	}
 }
 
-// TestHistoricalTrades tests the historical trades endpoint
+// TestHistoricalTrades tests fromId paging on the keyed historical trades endpoint
 func TestHistoricalTrades(t *testing.T) {
 	for _, config := range getTestConfigs() {
-		if config.AuthType != AuthTypeNONE {
+		if config.AuthType < AuthTypeUSER_DATA {
 			continue
 		}
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "HistoricalTrades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.SpotTradingAPI.GetHistoricalTradesV3(ctx).
-					Symbol("BTCUSDT").
-					Limit(10)
+				// Anchor paging on a trade id known to exist
+				recent, _, err := client.SpotTradingAPI.GetTradesV3(ctx).Symbol("BTCUSDT").Limit(20).Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get recent trades: %v", err)
+				}
+				if len(recent) == 0 || recent[0].Id == nil {
+					t.Skip("No recent trades to derive fromId from")
+				}
+				fromId := *recent[0].Id
 				
-				resp, httpResp, err := req.Execute()
+				const limit = 10
+				resp, httpResp, err := client.SpotTradingAPI.GetHistoricalTradesV3(ctx).
+					Symbol("BTCUSDT").
+					FromId(fromId).
+					Limit(limit).
+					Execute()
 				if err != nil {
 					checkAPIError(t, err)
-					// Historical trades might require authentication on some exchanges
-					if httpResp != nil && httpResp.StatusCode == 401 {
-						t.Skip("Historical trades endpoint requires authentication")
+					keyRejected := httpResp != nil && httpResp.StatusCode == 401
+					if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok && strings.Contains(string(apiErr.Body()), "-2014") {
+						keyRejected = true
+					}
+					if keyRejected {
+						t.Skip("Historical trades needs a keyed request with a valid API key (MARKET_DATA)")
 					}
 					t.Fatalf("Failed to get historical trades: %v", err)
 				}
 				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				// Verify response
 				if len(resp) == 0 {
-					t.Error("Expected historical trades in response")
+					t.Fatal("Expected historical trades in response")
+				}
+				if len(resp) > limit {
+					t.Errorf("Expected max %d trades, got %d", limit, len(resp))
 				}
 				
-				if len(resp) > 10 {
-					t.Errorf("Expected max 10 trades, got %d", len(resp))
+				// Ids must start at fromId and be contiguous
+				for i, trade := range resp {
+					if trade.Id == nil {
+						t.Fatalf("Trade %d has no id", i)
+					}
+					if want := fromId + int64(i); *trade.Id != want {
+						t.Errorf("Trade %d has id %d, want %d", i, *trade.Id, want)
+					}
 				}
+				
+				t.Logf("Historical trades from id %d: %d trades, ids %d-%d", fromId, len(resp), *resp[0].Id, *resp[len(resp)-1].Id)
 			})
 		})
 	}
@@ -724,4 +1060,56 @@ func TestRateLimitOrder(t *testing.T) {
 			})
 		})
 	}
-}
\ No newline at end of file
+}
+
+// TestRateLimitHeaders tests that the used-weight header survives on the returned
+// *http.Response, so callers can track their request weight
+func TestRateLimitHeaders(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "RateLimitHeaders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				_, httpResp, err := client.SpotTradingAPI.GetDepthV3(ctx).Symbol("BTCUSDT").Limit(5).Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get market depth: %v", err)
+				}
+				
+				if httpResp == nil {
+					t.Fatal("Expected an *http.Response alongside the decoded result")
+				}
+				
+				raw := httpResp.Header.Get(usedWeightHeader)
+				if raw == "" {
+					t.Fatalf("Expected %s header on the response; the SDK may be dropping response headers", usedWeightHeader)
+				}
+				
+				weight, ok := usedWeight(httpResp)
+				if !ok {
+					t.Fatalf("Expected an integer %s, got %q", usedWeightHeader, raw)
+				}
+				if weight <= 0 {
+					t.Errorf("Expected a positive %s after a weighted request, got %d", usedWeightHeader, weight)
+				}
+				
+				t.Logf("%s: %d", usedWeightHeader, weight)
+			})
+		})
+	}
+}
+
+// TestServerConfig tests that setupClient targets the spot testnet; no setting points the suite at mainnet
+func TestServerConfig(t *testing.T) {
+	client, _ := setupClient(TestConfig{Name: "Server Config", AuthType: AuthTypeNONE})
+
+	servers := client.GetConfig().Servers
+	if len(servers) == 0 {
+		t.Fatal("Client has no server configuration")
+	}
+	if got, want := servers[0].URL, "https://testnet.binance.vision"; got != want {
+		t.Fatalf("Active server URL is %s, want the testnet %s", got, want)
+	}
+}
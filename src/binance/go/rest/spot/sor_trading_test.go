@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -49,6 +51,44 @@ func getSorSupportedSymbol(client *openapi.APIClient, ctx context.Context) (stri
 	return "", fmt.Errorf("no symbols found in SOR configurations")
 }
 
+// getLotSize returns the LOT_SIZE step size and minimum quantity of a symbol. The filters are
+// read back through JSON since their shape varies by filter type.
+func getLotSize(client *openapi.APIClient, ctx context.Context, symbol string) (string, string, error) {
+	resp, _, err := client.SpotTradingAPI.GetExchangeInfoV3(ctx).Symbol(symbol).Execute()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get exchange info for %s: %v", symbol, err)
+	}
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to re-encode exchange info: %v", err)
+	}
+	
+	var info struct {
+		Symbols []struct {
+			Symbol  string `json:"symbol"`
+			Filters []struct {
+				FilterType string `json:"filterType"`
+				StepSize   string `json:"stepSize"`
+				MinQty     string `json:"minQty"`
+			} `json:"filters"`
+		} `json:"symbols"`
+	}
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return "", "", fmt.Errorf("failed to parse exchange info filters: %v", err)
+	}
+	for _, symbolInfo := range info.Symbols {
+		if symbolInfo.Symbol != symbol {
+			continue
+		}
+		for _, filter := range symbolInfo.Filters {
+			if filter.FilterType == "LOT_SIZE" && filter.StepSize != "" {
+				return filter.StepSize, filter.MinQty, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("LOT_SIZE filter not found for symbol %s", symbol)
+}
+
 // TestCreateSorOrder tests creating a SOR (Smart Order Routing) order
 func TestCreateSorOrder(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -222,14 +262,96 @@ func TestCreateSorOrderTest(t *testing.T) {
 					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
 				}
 				
-				// Test order typically returns empty response or basic validation result
-				_ = resp
+				// Without computeCommissionRates the test endpoint validates only and answers {}
+				body, err := json.Marshal(resp)
+				if err != nil {
+					t.Fatalf("Failed to re-encode SOR test order response: %v", err)
+				}
+				if string(body) != "{}" && string(body) != "null" {
+					t.Errorf("Expected an empty response from the validation-only endpoint, got %s", body)
+				}
+				
 				t.Log("SOR test order validated successfully")
 			})
 		})
 	}
 }
 
+// TestCreateSorOrderTestMinNotional tests that the SOR test order endpoint rejects an
+// order below the symbol's minimum notional, without anything being placed
+func TestCreateSorOrderTestMinNotional(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "CreateSorOrderTestMinNotional", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				sorSymbol, err := getSorSupportedSymbol(client, ctx)
+				if err != nil {
+					if strings.Contains(err.Error(), "status 400") || strings.Contains(err.Error(), "status 500") {
+						t.Fatalf("Failed to get exchange info: %v", err)
+					}
+					t.Skipf("No SOR-supported symbols available: %v", err)
+				}
+				
+				price, err := getCurrentPrice(client, ctx, sorSymbol)
+				if err != nil {
+					t.Fatalf("Failed to get current price for %s: %v", sorSymbol, err)
+				}
+				
+				stepSize, minQty, err := getLotSize(client, ctx, sorSymbol)
+				if err != nil {
+					t.Fatalf("Failed to get lot size for %s: %v", sorSymbol, err)
+				}
+				
+				// The smallest valid quantity at market price is far below the minimum notional
+				quantity := stepSize
+				if mq, parseErr := strconv.ParseFloat(minQty, 64); parseErr == nil && mq > 0 {
+					quantity = minQty
+				}
+				limitPriceStr := fmt.Sprintf("%.2f", price*0.99)
+				
+				_, httpResp, err := client.SpotTradingAPI.CreateSorOrderTestV3(ctx).
+					Symbol(sorSymbol).
+					Side("BUY").
+					Type_("LIMIT").
+					TimeInForce("GTC").
+					Quantity(quantity).
+					Price(limitPriceStr).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err == nil {
+					t.Fatalf("Expected SOR test order of %s %s at %s to fail min notional validation", quantity, sorSymbol, limitPriceStr)
+				}
+				
+				if httpResp == nil {
+					t.Fatalf("Expected an HTTP error response, got: %v", err)
+				}
+				if httpResp.StatusCode == 404 {
+					t.Skip("SOR test orders not available on this account/testnet")
+				}
+				
+				apiErr, ok := err.(*openapi.GenericOpenAPIError)
+				if !ok {
+					t.Fatalf("Expected an API error, got %T: %v", err, err)
+				}
+				body := string(apiErr.Body())
+				if strings.Contains(body, "This symbol has no SOR") {
+					t.Skip("Symbol does not support SOR - this is expected for symbols not in SOR configuration")
+				}
+				
+				if httpResp.StatusCode != 400 || !strings.Contains(body, "-1013") || !strings.Contains(body, "NOTIONAL") {
+					t.Errorf("Expected 400 with -1013 NOTIONAL filter failure, got status %d: %s", httpResp.StatusCode, body)
+				}
+				
+				t.Logf("SOR test order rejected as expected: %s", body)
+			})
+		})
+	}
+}
+
 // TestGetMyAllocations tests retrieving SOR allocations
 func TestGetMyAllocations(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -1,9 +1,16 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/spot"
 )
@@ -64,6 +71,98 @@ func handleTestnetError(t *testing.T, err error, httpResp *http.Response, testNa
 	return false
 }
 
+const (
+	// mainnetEnvVar enables the SAPI tests, which only exist on the production server
+	mainnetEnvVar = "BINANCE_TEST_SPOT_MAINNET"
+	// spotMainnetURL is the production REST base that serves the SAPI endpoints
+	spotMainnetURL = "https://api.binance.com"
+	// invalidKeyIPPermissionsCode is Binance's "Invalid API-key, IP, or permissions for action" error
+	invalidKeyIPPermissionsCode = -2015
+)
+
+// mainnetKey names the environment variables holding one production HMAC key pair
+type mainnetKey struct {
+	apiKeyEnv, secretKeyEnv string
+	authType                AuthType
+	description             string
+}
+
+var (
+	// mainnetReadKey is the read-only key used by the SAPI status tests
+	mainnetReadKey = mainnetKey{"BINANCE_MAINNET_API_KEY", "BINANCE_MAINNET_SECRET_KEY", AuthTypeUSER_DATA, "a read-only production key"}
+	// mainnetTradeKey is a separate key with trading enabled, only used by tests that move real funds
+	mainnetTradeKey = mainnetKey{"BINANCE_MAINNET_TRADE_API_KEY", "BINANCE_MAINNET_TRADE_SECRET_KEY", AuthTypeTRADE, "a production key with trading enabled"}
+)
+
+// mainnetEndpoint runs testFunc against the production server with the read-only mainnet key,
+// skipping unless BINANCE_TEST_SPOT_MAINNET is "true" and the key is configured
+func mainnetEndpoint(t *testing.T, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
+	mainnetEndpointWithKey(t, testName, mainnetReadKey, testFunc)
+}
+
+// mainnetEndpointWithKey is mainnetEndpoint with an explicit production key
+func mainnetEndpointWithKey(t *testing.T, testName string, key mainnetKey, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
+	if os.Getenv(mainnetEnvVar) != "true" {
+		t.Skipf("%s is a SAPI endpoint with no testnet. Set %s=true to run it against production", testName, mainnetEnvVar)
+	}
+	apiKey, secretKey := os.Getenv(key.apiKeyEnv), os.Getenv(key.secretKeyEnv)
+	if apiKey == "" || secretKey == "" {
+		t.Skipf("%s needs %s and %s (%s)", testName, key.apiKeyEnv, key.secretKeyEnv, key.description)
+	}
+
+	rateLimiter.WaitForRateLimit()
+	client, ctx := setupClient(TestConfig{
+		Name:      "Mainnet HMAC",
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		SignType:  "HMAC",
+		AuthType:  key.authType,
+	})
+	client.GetConfig().Servers = openapi.ServerConfigurations{
+		{
+			URL:         spotMainnetURL,
+			Description: "Binance Spot Production",
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	testFunc(t, client, timeoutCtx)
+}
+
+// sapiErrorGuidance returns actionable guidance for the key, IP and permission errors SAPI
+// endpoints commonly return, or an empty string when the error needs no extra explanation
+func sapiErrorGuidance(err error, httpResp *http.Response) string {
+	var apiErr struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if bodyErr, ok := err.(interface{ Body() []byte }); ok {
+		json.Unmarshal(bodyErr.Body(), &apiErr)
+	}
+
+	switch {
+	case apiErr.Code == invalidKeyIPPermissionsCode:
+		return fmt.Sprintf("Binance rejected the API key (code %d: %s): check that the key's IP allowlist "+
+			"includes this machine's egress IP, that \"Enable Reading\" is granted, and that it is a production key", apiErr.Code, apiErr.Msg)
+	case httpResp != nil && (httpResp.StatusCode == http.StatusUnauthorized || httpResp.StatusCode == http.StatusForbidden):
+		return fmt.Sprintf("HTTP %d from SAPI: the key lacks permission for this endpoint or the IP is blocked", httpResp.StatusCode)
+	case httpResp != nil && httpResp.StatusCode == http.StatusTeapot:
+		return "HTTP 418: this IP has been auto-banned for exceeding rate limits; wait before retrying"
+	}
+	return ""
+}
+
+// failSAPI fails a SAPI test, adding guidance for key, IP and permission errors
+func failSAPI(t *testing.T, err error, httpResp *http.Response, testName string) {
+	t.Helper()
+	checkAPIError(t, err)
+	if guidance := sapiErrorGuidance(err, httpResp); guidance != "" {
+		t.Fatalf("%s failed: %v\n%s", testName, err, guidance)
+	}
+	t.Fatalf("%s failed: %v", testName, err)
+}
+
 // logAPIError safely logs API error details for debugging
 func logAPIError(t *testing.T, err error) {
 	if apiErr, ok := err.(*openapi.GenericOpenAPIError); ok {
@@ -71,3 +170,98 @@ func logAPIError(t *testing.T, err error) {
 	}
 }
 
+// fetchSymbolStatuses maps each exchangeInfo symbol to its status using a public client on the configured server
+func fetchSymbolStatuses(ctx context.Context) (map[string]string, error) {
+	publicClient, _ := setupClient(TestConfig{Name: "Symbol Status", AuthType: AuthTypeNONE})
+
+	resp, _, err := publicClient.SpotTradingAPI.GetExchangeInfoV3(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string, len(resp.Symbols))
+	for _, s := range resp.Symbols {
+		if s.Symbol != nil && s.Status != nil {
+			statuses[*s.Symbol] = *s.Status
+		}
+	}
+	return statuses, nil
+}
+
+// Symbol statuses are fetched once per run and shared by every status check
+var (
+	symbolStatusesOnce sync.Once
+	symbolStatusesMap  map[string]string
+	symbolStatusesErr  error
+)
+
+// symbolStatuses returns the exchangeInfo statuses, fetching them on first use. A failed fetch
+// is cached too, so an unreachable exchange costs one timeout rather than one per test.
+func symbolStatuses() (map[string]string, error) {
+	symbolStatusesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		symbolStatusesMap, symbolStatusesErr = fetchSymbolStatuses(ctx)
+	})
+	return symbolStatusesMap, symbolStatusesErr
+}
+
+// nonTradingReason explains why symbol cannot take orders, or returns "" when it is TRADING.
+// statuses maps each exchangeInfo symbol to its status.
+func nonTradingReason(symbol string, statuses map[string]string) string {
+	status, ok := statuses[symbol]
+	if !ok {
+		return fmt.Sprintf("%s is not listed in exchangeInfo", symbol)
+	}
+	if status != "TRADING" {
+		return fmt.Sprintf("%s has market status %s, not TRADING; skipping as a market-state issue, not an SDK bug", symbol, status)
+	}
+	return ""
+}
+
+// requireTrading skips the test when symbol is not currently TRADING.
+// If exchangeInfo cannot be fetched the test continues and reports its own failure.
+func requireTrading(t *testing.T, symbol string) {
+	t.Helper()
+
+	statuses, err := symbolStatuses()
+	if err != nil {
+		t.Logf("Warning: could not check market status of %s: %v", symbol, err)
+		return
+	}
+	if reason := nonTradingReason(symbol, statuses); reason != "" {
+		t.Skip(reason)
+	}
+}
+
+// usedWeightHeader reports the IP's request weight used in the current 1-minute window
+const usedWeightHeader = "X-MBX-USED-WEIGHT-1M"
+
+// usedWeight parses the used-weight header of a response, reporting false if it is absent
+func usedWeight(httpResp *http.Response) (int, bool) {
+	if httpResp == nil {
+		return 0, false
+	}
+	weight, err := strconv.Atoi(httpResp.Header.Get(usedWeightHeader))
+	if err != nil {
+		return 0, false
+	}
+	return weight, true
+}
+
+// orderCountHeaderPrefix starts the per-interval order count headers, e.g. X-MBX-ORDER-COUNT-10S
+const orderCountHeaderPrefix = "X-MBX-ORDER-COUNT-"
+
+// orderCounts returns the order count headers of a response keyed by header name
+func orderCounts(httpResp *http.Response) map[string]string {
+	counts := make(map[string]string)
+	if httpResp == nil {
+		return counts
+	}
+	for name, values := range httpResp.Header {
+		if strings.HasPrefix(strings.ToUpper(name), orderCountHeaderPrefix) && len(values) > 0 {
+			counts[strings.ToUpper(name)] = values[0]
+		}
+	}
+	return counts
+}
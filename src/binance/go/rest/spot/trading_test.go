@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"math"
+	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +30,8 @@ func TestCreateOrder(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "CreateOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
 				// Get current price for placing a limit order
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
@@ -91,6 +96,173 @@ func TestCreateOrder(t *testing.T) {
 	}
 }
 
+// TestOrderCountHeaders tests that order placement responses carry the used-weight and
+// X-MBX-ORDER-COUNT-* headers
+func TestOrderCountHeaders(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "OrderCountHeaders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
+				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
+				if err != nil {
+					t.Fatalf("Failed to get current price: %v", err)
+				}
+				
+				// Place a limit order far below market so it rests on the book
+				resp, httpResp, err := client.SpotTradingAPI.CreateOrderV3(ctx).
+					Symbol("BTCUSDT").
+					Side("BUY").
+					Type_("LIMIT").
+					TimeInForce("GTC").
+					Quantity("0.0001").
+					Price(fmt.Sprintf("%.2f", price*0.5)).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if handleTestnetError(t, err, httpResp, "OrderCountHeaders") {
+					return
+				}
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to create order: %v", err)
+				}
+				
+				if resp.OrderId != nil {
+					defer func() {
+						rateLimiter.WaitForRateLimit()
+						_, _, cancelErr := client.SpotTradingAPI.DeleteOrderV3(ctx).
+							Symbol("BTCUSDT").
+							OrderId(*resp.OrderId).
+							Timestamp(generateTimestamp()).
+							RecvWindow(5000).
+							Execute()
+						if cancelErr != nil {
+							t.Logf("Warning: Failed to cancel test order: %v", cancelErr)
+						}
+					}()
+				}
+				
+				if weight, ok := usedWeight(httpResp); ok {
+					t.Logf("%s: %d", usedWeightHeader, weight)
+				} else {
+					t.Errorf("Expected an integer %s on the order response, got %q", usedWeightHeader, httpResp.Header.Get(usedWeightHeader))
+				}
+				
+				counts := orderCounts(httpResp)
+				if len(counts) == 0 {
+					t.Fatalf("Expected %s* headers on the order response", orderCountHeaderPrefix)
+				}
+				for name, value := range counts {
+					if _, err := strconv.Atoi(value); err != nil {
+						t.Errorf("Expected an integer %s, got %q", name, value)
+					}
+					t.Logf("%s: %s", name, value)
+				}
+			})
+		})
+	}
+}
+
+// TestNewOrderRespType tests that each newOrderRespType changes the order response shape:
+// ACK omits status and quantities, RESULT adds them, and FULL also carries a fills array
+func TestNewOrderRespType(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "NewOrderRespType", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
+				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
+				if err != nil {
+					t.Fatalf("Failed to get current price: %v", err)
+				}
+				priceStr := fmt.Sprintf("%.2f", price*0.5)
+				
+				for _, respType := range []string{"ACK", "RESULT", "FULL"} {
+					rateLimiter.WaitForRateLimit()
+					// Resting far below market so the order never fills before it is canceled
+					resp, httpResp, err := client.SpotTradingAPI.CreateOrderV3(ctx).
+						Symbol("BTCUSDT").
+						Side("BUY").
+						Type_("LIMIT").
+						TimeInForce("GTC").
+						Quantity("0.0001").
+						Price(priceStr).
+						NewOrderRespType(respType).
+						Timestamp(generateTimestamp()).
+						RecvWindow(5000).
+						Execute()
+					if handleTestnetError(t, err, httpResp, "NewOrderRespType") {
+						return
+					}
+					if err != nil {
+						checkAPIError(t, err)
+						t.Fatalf("Failed to create order with newOrderRespType=%s: %v", respType, err)
+					}
+					if resp.OrderId == nil || *resp.OrderId == 0 {
+						t.Fatalf("%s: expected orderId in response", respType)
+					}
+					
+					rateLimiter.WaitForRateLimit()
+					_, _, cancelErr := client.SpotTradingAPI.DeleteOrderV3(ctx).
+						Symbol("BTCUSDT").
+						OrderId(*resp.OrderId).
+						Timestamp(generateTimestamp()).
+						RecvWindow(5000).
+						Execute()
+					if cancelErr != nil {
+						t.Logf("Warning: Failed to cancel %s test order %d: %v", respType, *resp.OrderId, cancelErr)
+					}
+					
+					if resp.Symbol == nil || *resp.Symbol != "BTCUSDT" {
+						t.Errorf("%s: expected symbol BTCUSDT, got %v", respType, resp.Symbol)
+					}
+					if resp.TransactTime == nil || *resp.TransactTime == 0 {
+						t.Errorf("%s: expected transactTime", respType)
+					}
+					
+					switch respType {
+					case "ACK":
+						if resp.Status != nil || resp.ExecutedQty != nil || resp.OrigQty != nil {
+							t.Errorf("ACK: expected no status or quantities, got status=%v executedQty=%v origQty=%v",
+								resp.Status, resp.ExecutedQty, resp.OrigQty)
+						}
+						if resp.Fills != nil {
+							t.Errorf("ACK: expected no fills, got %d", len(resp.Fills))
+						}
+					case "RESULT", "FULL":
+						if resp.Status == nil || *resp.Status != "NEW" {
+							t.Errorf("%s: expected status NEW, got %v", respType, resp.Status)
+						}
+						if resp.ExecutedQty == nil || resp.OrigQty == nil || resp.Price == nil {
+							t.Errorf("%s: expected executedQty, origQty and price, got %v, %v, %v",
+								respType, resp.ExecutedQty, resp.OrigQty, resp.Price)
+						}
+						// Only FULL carries a fills array, empty for an order resting on the book
+						if respType == "RESULT" && resp.Fills != nil {
+							t.Errorf("RESULT: expected no fills array, got %d fills", len(resp.Fills))
+						}
+						if respType == "FULL" && resp.Fills == nil {
+							t.Error("FULL: expected a fills array")
+						}
+					}
+					
+					t.Logf("%s: orderId=%d status=%v executedQty=%v fills=%v",
+						respType, *resp.OrderId, resp.Status, resp.ExecutedQty, resp.Fills != nil)
+				}
+			})
+		})
+	}
+}
+
 // TestQueryOrder tests order query functionality
 func TestQueryOrder(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -100,6 +272,8 @@ func TestQueryOrder(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "QueryOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
 				// First create an order to query
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
@@ -194,6 +368,8 @@ func TestCancelOrder(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "CancelOrder", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
 				// First create an order to cancel
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
@@ -512,6 +688,165 @@ func TestOpenOrders(t *testing.T) {
 	}
 }
 
+// TestSpotOpenOrders tests that openOrders without a symbol returns every symbol's open orders
+// at a higher request weight, while with a symbol it returns only that symbol's
+func TestSpotOpenOrders(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType < AuthTypeUSER_DATA {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "SpotOpenOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				// Bracket the all-symbols call with symbol-scoped calls so each weight delta is isolated
+				symbolResp, symbolHttpResp, err := client.SpotTradingAPI.GetOpenOrdersV3(ctx).
+					Symbol("BTCUSDT").
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get open orders for BTCUSDT: %v", err)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				allResp, allHttpResp, err := client.SpotTradingAPI.GetOpenOrdersV3(ctx).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get open orders for all symbols: %v", err)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				_, afterHttpResp, err := client.SpotTradingAPI.GetOpenOrdersV3(ctx).
+					Symbol("BTCUSDT").
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get open orders for BTCUSDT: %v", err)
+				}
+				
+				for i, order := range symbolResp {
+					if order.Symbol == nil || *order.Symbol != "BTCUSDT" {
+						t.Errorf("Symbol-scoped order %d: expected symbol BTCUSDT, got %v", i, order.Symbol)
+					}
+				}
+				
+				symbols := make(map[string]int)
+				allIds := make(map[int64]bool, len(allResp))
+				for i, order := range allResp {
+					if order.Symbol == nil || *order.Symbol == "" {
+						t.Errorf("All-symbols order %d: expected a symbol", i)
+						continue
+					}
+					symbols[*order.Symbol]++
+					if order.OrderId != nil {
+						allIds[*order.OrderId] = true
+					}
+				}
+				
+				// Every BTCUSDT order must also appear in the all-symbols response
+				for _, order := range symbolResp {
+					if order.OrderId != nil && !allIds[*order.OrderId] {
+						t.Errorf("BTCUSDT open order %d missing from the all-symbols response", *order.OrderId)
+					}
+				}
+				t.Logf("Open orders: %d for BTCUSDT, %d across %d symbols", len(symbolResp), len(allResp), len(symbols))
+				
+				// The used-weight counter is cumulative per minute, so compare the increments
+				w0, ok0 := usedWeight(symbolHttpResp)
+				w1, ok1 := usedWeight(allHttpResp)
+				w2, ok2 := usedWeight(afterHttpResp)
+				if !ok0 || !ok1 || !ok2 {
+					t.Logf("%s header missing; skipping weight comparison", usedWeightHeader)
+					return
+				}
+				allDelta, symbolDelta := w1-w0, w2-w1
+				if allDelta < 0 || symbolDelta < 0 {
+					t.Logf("Used weight reset mid-test (%d, %d, %d); skipping weight comparison", w0, w1, w2)
+					return
+				}
+				if allDelta <= symbolDelta {
+					t.Errorf("Expected openOrders without a symbol to cost more weight: all-symbols +%d, symbol +%d", allDelta, symbolDelta)
+				}
+				t.Logf("Used weight: all-symbols +%d, symbol +%d", allDelta, symbolDelta)
+			})
+		})
+	}
+}
+
+// TestSpotAllOrders tests that allOrders requires a symbol and validates the returned orders
+func TestSpotAllOrders(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType < AuthTypeUSER_DATA {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "SpotAllOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				// Without a symbol the request must be rejected, by the SDK or by the API
+				_, _, err := client.SpotTradingAPI.GetAllOrdersV3(ctx).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err == nil {
+					t.Error("Expected allOrders without a symbol to be rejected")
+				} else {
+					t.Logf("allOrders without a symbol rejected as expected: %v", err)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				resp, httpResp, err := client.SpotTradingAPI.GetAllOrdersV3(ctx).
+					Symbol("BTCUSDT").
+					Limit(50).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get all orders: %v", err)
+				}
+				
+				if httpResp.StatusCode != 200 {
+					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+				}
+				
+				for i, order := range resp {
+					if order.OrderId == nil || *order.OrderId == 0 {
+						t.Errorf("Order %d: expected order ID", i)
+					}
+					if order.Symbol == nil || *order.Symbol != "BTCUSDT" {
+						t.Errorf("Order %d: expected symbol BTCUSDT, got %v", i, order.Symbol)
+					}
+					if order.Status == nil || *order.Status == "" {
+						t.Errorf("Order %d: expected order status", i)
+					}
+					if order.Side == nil || (*order.Side != "BUY" && *order.Side != "SELL") {
+						t.Errorf("Order %d: expected side BUY or SELL, got %v", i, order.Side)
+					}
+					if order.Type == nil || *order.Type == "" {
+						t.Errorf("Order %d: expected order type", i)
+					}
+					if order.OrigQty == nil {
+						t.Errorf("Order %d: expected origQty", i)
+					} else if _, err := strconv.ParseFloat(*order.OrigQty, 64); err != nil {
+						t.Errorf("Order %d: origQty %q is not numeric", i, *order.OrigQty)
+					}
+					if order.Time == nil || *order.Time <= 0 {
+						t.Errorf("Order %d: expected creation time", i)
+					}
+				}
+				
+				t.Logf("Validated %d BTCUSDT orders from allOrders", len(resp))
+			})
+		})
+	}
+}
+
 // TestDeleteOpenOrders tests canceling all open orders
 func TestDeleteOpenOrders(t *testing.T) {
 	for _, config := range getTestConfigs() {
@@ -521,6 +856,8 @@ func TestDeleteOpenOrders(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "DeleteOpenOrders", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
 				// First create an order to ensure we have something to cancel
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
@@ -637,6 +974,8 @@ func TestOrderCancelReplace(t *testing.T) {
 		
 		t.Run(config.Name, func(t *testing.T) {
 			testEndpoint(t, config, "OrderCancelReplace", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
 				// First create an order to cancel-replace
 				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
 				if err != nil {
@@ -753,4 +1092,484 @@ func TestOrderCancelReplace(t *testing.T) {
 			})
 		})
 	}
-}
\ No newline at end of file
+}
+
+// TestOrderAmendKeepPriority tests reducing an order's quantity with the amend keep-priority endpoint
+func TestOrderAmendKeepPriority(t *testing.T) {
+	if os.Getenv("BINANCE_TEST_ORDER_AMEND") != "true" {
+		t.Skip("Order amend tests disabled. Set BINANCE_TEST_ORDER_AMEND=true to enable")
+	}
+
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "OrderAmendKeepPriority", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
+				price, err := getCurrentPrice(client, ctx, "BTCUSDT")
+				if err != nil {
+					t.Fatalf("Failed to get current price: %v", err)
+				}
+				
+				orderPriceStr := fmt.Sprintf("%.2f", price*0.5)
+				origQty := "0.0004"
+				newQty := "0.0002"
+				
+				createResp, httpResp, err := client.SpotTradingAPI.CreateOrderV3(ctx).
+					Symbol("BTCUSDT").
+					Side("BUY").
+					Type_("LIMIT").
+					TimeInForce("GTC").
+					Quantity(origQty).
+					Price(orderPriceStr).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if handleTestnetError(t, err, httpResp, "OrderAmendKeepPriority") {
+					return
+				}
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to create order for amend test: %v", err)
+				}
+				
+				if createResp.OrderId == nil {
+					t.Fatal("No order ID returned from create order")
+				}
+				orderId := *createResp.OrderId
+				
+				defer func() {
+					rateLimiter.WaitForRateLimit()
+					_, _, cancelErr := client.SpotTradingAPI.DeleteOrderV3(ctx).
+						Symbol("BTCUSDT").
+						OrderId(orderId).
+						Timestamp(generateTimestamp()).
+						RecvWindow(5000).
+						Execute()
+					if cancelErr != nil {
+						t.Logf("Warning: Failed to cancel amended order: %v", cancelErr)
+					}
+				}()
+				
+				// workingTime marks the order's queue position and is used as the priority check below
+				rateLimiter.WaitForRateLimit()
+				before, _, err := client.SpotTradingAPI.GetOrderV3(ctx).
+					Symbol("BTCUSDT").
+					OrderId(orderId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to query order before amend: %v", err)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				amendResp, httpResp, err := client.SpotTradingAPI.UpdateOrderAmendKeepPriorityV3(ctx).
+					Symbol("BTCUSDT").
+					OrderId(orderId).
+					NewQty(newQty).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if handleTestnetError(t, err, httpResp, "OrderAmendKeepPriority") {
+					return
+				}
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to amend order: %v", err)
+				}
+				
+				if amendResp.AmendedOrder == nil {
+					t.Fatal("Expected amendedOrder in response")
+				}
+				amended := amendResp.AmendedOrder
+				if amended.OrderId == nil || *amended.OrderId != orderId {
+					t.Errorf("Amended order should keep orderId %d, got %v", orderId, amended.OrderId)
+				}
+				
+				// Query again to confirm the amendment is persisted, not just echoed
+				rateLimiter.WaitForRateLimit()
+				after, _, err := client.SpotTradingAPI.GetOrderV3(ctx).
+					Symbol("BTCUSDT").
+					OrderId(orderId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to query order after amend: %v", err)
+				}
+				
+				expectedQty, _ := strconv.ParseFloat(newQty, 64)
+				if after.OrigQty == nil {
+					t.Error("Expected origQty in queried order")
+				} else if actualQty, err := strconv.ParseFloat(*after.OrigQty, 64); err != nil || abs(expectedQty-actualQty) > 1e-9 {
+					t.Errorf("Expected origQty %s after amend, got %s", newQty, *after.OrigQty)
+				}
+				
+				if before.WorkingTime != nil && after.WorkingTime != nil && *before.WorkingTime != *after.WorkingTime {
+					t.Errorf("Amending quantity down should keep priority: workingTime changed from %d to %d",
+						*before.WorkingTime, *after.WorkingTime)
+				}
+				
+				rateLimiter.WaitForRateLimit()
+				history, _, err := client.SpotTradingAPI.GetOrderAmendmentsV3(ctx).
+					Symbol("BTCUSDT").
+					OrderId(orderId).
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to get order amendments: %v", err)
+				}
+				
+				found := false
+				for _, amendment := range history {
+					if amendment.OrderId == nil || *amendment.OrderId != orderId || amendment.NewQty == nil {
+						continue
+					}
+					if qty, err := strconv.ParseFloat(*amendment.NewQty, 64); err == nil && abs(expectedQty-qty) <= 1e-9 {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Amendment to %s not found in history (%d entries)", newQty, len(history))
+				}
+				
+				t.Logf("Order %d amended from %s to %s with %d history entries", orderId, origQty, newQty, len(history))
+			})
+		})
+	}
+}
+
+// fillTolerance is the relative rounding tolerance when summing fills against order totals
+const fillTolerance = 1e-8
+
+// orderFill is one execution of an order as reported in its fills array
+type orderFill struct {
+	Price   string
+	Qty     string
+	TradeId int64
+}
+
+// filledOrder holds the order totals that its fills must add up to
+type filledOrder struct {
+	ExecutedQty string
+	CumQuote    string
+	Fills       []orderFill
+}
+
+// withinTolerance reports whether got matches want up to fillTolerance relative to want
+func withinTolerance(got, want float64) bool {
+	return abs(got-want) <= fillTolerance*math.Max(1, abs(want))
+}
+
+// fillsInconsistencies lists every way the fills of order disagree with its totals
+func fillsInconsistencies(order filledOrder) []string {
+	var problems []string
+	executedQty, err := strconv.ParseFloat(order.ExecutedQty, 64)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("invalid executedQty %q", order.ExecutedQty))
+	}
+	cumQuote, err := strconv.ParseFloat(order.CumQuote, 64)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("invalid cumQuote %q", order.CumQuote))
+	}
+
+	totalQty, totalQuote := 0.0, 0.0
+	seen := make(map[int64]bool)
+	for i, fill := range order.Fills {
+		if fill.TradeId <= 0 {
+			problems = append(problems, fmt.Sprintf("fill %d has invalid tradeId %d", i, fill.TradeId))
+		} else if seen[fill.TradeId] {
+			problems = append(problems, fmt.Sprintf("fill %d repeats tradeId %d", i, fill.TradeId))
+		}
+		seen[fill.TradeId] = true
+
+		price, priceErr := strconv.ParseFloat(fill.Price, 64)
+		qty, qtyErr := strconv.ParseFloat(fill.Qty, 64)
+		if priceErr != nil || qtyErr != nil {
+			problems = append(problems, fmt.Sprintf("fill %d has invalid price %q or qty %q", i, fill.Price, fill.Qty))
+			continue
+		}
+		totalQty += qty
+		totalQuote += price * qty
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+
+	if !withinTolerance(totalQty, executedQty) {
+		problems = append(problems, fmt.Sprintf("summed fill qty %v does not match executedQty %v", totalQty, executedQty))
+	}
+	if !withinTolerance(totalQuote, cumQuote) {
+		problems = append(problems, fmt.Sprintf("summed fill notional %v does not match cumQuote %v", totalQuote, cumQuote))
+	}
+	return problems
+}
+
+// assertFillsConsistent fails the test if the fills of order do not add up to its
+// executedQty and cumQuote or carry an invalid tradeId
+func assertFillsConsistent(t *testing.T, order filledOrder) {
+	t.Helper()
+	for _, problem := range fillsInconsistencies(order) {
+		t.Errorf("Fills inconsistent: %s", problem)
+	}
+}
+
+// floorToStep rounds quantity down to a multiple of the LOT_SIZE stepSize, formatted at the step's precision
+func floorToStep(quantity float64, stepSize string) (string, error) {
+	step, err := strconv.ParseFloat(stepSize, 64)
+	if err != nil || step <= 0 {
+		return "", fmt.Errorf("invalid step size %q", stepSize)
+	}
+	decimals := 0
+	if dot := strings.IndexByte(stepSize, '.'); dot >= 0 {
+		decimals = len(strings.TrimRight(stepSize[dot+1:], "0"))
+	}
+	// The epsilon keeps a quantity already on the step from losing a whole step to float error
+	return strconv.FormatFloat(math.Floor(quantity/step+1e-9)*step, 'f', decimals, 64), nil
+}
+
+// TestOrderFills tests the fills array of a filled market order
+func TestOrderFills(t *testing.T) {
+	if os.Getenv("BINANCE_TEST_ORDER_FILL") != "true" {
+		t.Skip("Order fill tests disabled. Set BINANCE_TEST_ORDER_FILL=true to enable")
+	}
+
+	const (
+		baseAsset  = "BTC"
+		quoteAsset = "USDT"
+	)
+
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeTRADE {
+			continue
+		}
+		
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "OrderFills", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+				requireTrading(t, "BTCUSDT")
+				
+				// Read the lot step before buying so the flattening sell below can always be sized
+				stepSize, _, err := getLotSize(client, ctx, "BTCUSDT")
+				if err != nil {
+					t.Fatalf("Failed to get lot size for BTCUSDT: %v", err)
+				}
+				
+				resp, httpResp, err := client.SpotTradingAPI.CreateOrderV3(ctx).
+					Symbol("BTCUSDT").
+					Side("BUY").
+					Type_("MARKET").
+					Quantity("0.0002").
+					NewOrderRespType("FULL").
+					Timestamp(generateTimestamp()).
+					RecvWindow(5000).
+					Execute()
+				if handleTestnetError(t, err, httpResp, "OrderFills") {
+					return
+				}
+				if err != nil {
+					checkAPIError(t, err)
+					t.Fatalf("Failed to create market order: %v", err)
+				}
+				
+				if resp.ExecutedQty == nil {
+					t.Fatal("Expected executedQty in FULL response")
+				}
+				executedQty, err := strconv.ParseFloat(*resp.ExecutedQty, 64)
+				if err != nil {
+					t.Fatalf("Invalid executedQty %q: %v", *resp.ExecutedQty, err)
+				}
+				
+				// Commission charged in the base asset reduces what can be sold back
+				baseCommission := 0.0
+				defer func() {
+					sellQty := executedQty - baseCommission
+					if sellQty <= 0 {
+						return
+					}
+					// Truncate to the lot step so the flattening order is accepted
+					sellQtyStr, stepErr := floorToStep(sellQty, stepSize)
+					if stepErr != nil {
+						t.Logf("Warning: Failed to flatten position of %v BTC: %v", sellQty, stepErr)
+						return
+					}
+					rateLimiter.WaitForRateLimit()
+					_, _, sellErr := client.SpotTradingAPI.CreateOrderV3(ctx).
+						Symbol("BTCUSDT").
+						Side("SELL").
+						Type_("MARKET").
+						Quantity(sellQtyStr).
+						Timestamp(generateTimestamp()).
+						RecvWindow(5000).
+						Execute()
+					if sellErr != nil {
+						t.Logf("Warning: Failed to flatten position of %s BTC: %v", sellQtyStr, sellErr)
+					}
+				}()
+				
+				if len(resp.Fills) == 0 {
+					t.Fatalf("Expected fills for filled market order (status %v)", resp.Status)
+				}
+				
+				totalCommission := 0.0
+				for i, fill := range resp.Fills {
+					if fill.Qty == nil || fill.Commission == nil || fill.CommissionAsset == nil {
+						t.Errorf("Fill %d missing qty, commission or commissionAsset", i)
+						continue
+					}
+					
+					commission, err := strconv.ParseFloat(*fill.Commission, 64)
+					if err != nil {
+						t.Errorf("Fill %d has invalid commission %q", i, *fill.Commission)
+						continue
+					}
+					totalCommission += commission
+					
+					switch *fill.CommissionAsset {
+					case baseAsset:
+						baseCommission += commission
+					case quoteAsset, "BNB":
+					default:
+						t.Errorf("Fill %d has unexpected commissionAsset %q, want %s, %s or BNB",
+							i, *fill.CommissionAsset, baseAsset, quoteAsset)
+					}
+				}
+				
+				order := filledOrder{ExecutedQty: *resp.ExecutedQty}
+				if resp.CummulativeQuoteQty != nil {
+					order.CumQuote = *resp.CummulativeQuoteQty
+				}
+				for _, fill := range resp.Fills {
+					var f orderFill
+					if fill.Price != nil {
+						f.Price = *fill.Price
+					}
+					if fill.Qty != nil {
+						f.Qty = *fill.Qty
+					}
+					if fill.TradeId != nil {
+						f.TradeId = *fill.TradeId
+					}
+					order.Fills = append(order.Fills, f)
+				}
+				assertFillsConsistent(t, order)
+				
+				if totalCommission < 0 {
+					t.Errorf("Summed commission should be non-negative, got %v", totalCommission)
+				}
+				
+				t.Logf("Order %v filled %v BTC across %d fills with total commission %v",
+					resp.OrderId, executedQty, len(resp.Fills), totalCommission)
+			})
+		})
+	}
+}
+
+// TestNonTradingReason verifies which market states make requireTrading skip
+func TestNonTradingReason(t *testing.T) {
+	statuses := map[string]string{
+		"BTCUSDT": "TRADING",
+		"ETHUSDT": "BREAK",
+	}
+
+	tests := []struct {
+		symbol   string
+		wantSkip string
+	}{
+		{"BTCUSDT", ""},
+		{"ETHUSDT", "market status BREAK"},
+		{"DOESNOTEXIST", "not listed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			reason := nonTradingReason(tt.symbol, statuses)
+			if tt.wantSkip == "" {
+				if reason != "" {
+					t.Fatalf("Expected %s to be tradable, got skip reason: %s", tt.symbol, reason)
+				}
+				return
+			}
+			if !strings.Contains(reason, tt.wantSkip) || !strings.Contains(reason, tt.symbol) {
+				t.Fatalf("Skip reason should mention %q and the symbol, got: %q", tt.wantSkip, reason)
+			}
+		})
+	}
+}
+
+// TestFillsConsistent verifies the fills accounting on a synthetic multi-fill order
+func TestFillsConsistent(t *testing.T) {
+	base := func() filledOrder {
+		return filledOrder{
+			ExecutedQty: "0.00030000",
+			CumQuote:    "19.50030000",
+			Fills: []orderFill{
+				{Price: "65000.00", Qty: "0.00010000", TradeId: 101},
+				{Price: "65000.10", Qty: "0.00010000", TradeId: 102},
+				{Price: "65000.20", Qty: "0.00010000", TradeId: 103},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*filledOrder)
+		wantErr string
+	}{
+		{"Consistent", func(o *filledOrder) {}, ""},
+		{"QtyMismatch", func(o *filledOrder) { o.ExecutedQty = "0.00040000" }, "executedQty"},
+		{"NotionalMismatch", func(o *filledOrder) { o.CumQuote = "19.60000000" }, "cumQuote"},
+		{"MissingTradeId", func(o *filledOrder) { o.Fills[1].TradeId = 0 }, "invalid tradeId"},
+		{"DuplicateTradeId", func(o *filledOrder) { o.Fills[2].TradeId = 101 }, "repeats tradeId"},
+		{"InvalidPrice", func(o *filledOrder) { o.Fills[0].Price = "" }, "invalid price"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := base()
+			tt.mutate(&order)
+			problems := fillsInconsistencies(order)
+			if tt.wantErr == "" {
+				if len(problems) != 0 {
+					t.Fatalf("Expected consistent fills, got %v", problems)
+				}
+				return
+			}
+			if len(problems) == 0 || !strings.Contains(strings.Join(problems, "; "), tt.wantErr) {
+				t.Fatalf("Expected a problem mentioning %q, got %v", tt.wantErr, problems)
+			}
+		})
+	}
+}
+
+// TestFloorToStep verifies that flattening quantities are rounded down onto the lot step
+func TestFloorToStep(t *testing.T) {
+	tests := []struct {
+		quantity float64
+		stepSize string
+		want     string
+	}{
+		{0.0001998, "0.00001000", "0.00019"},
+		{0.0002, "0.00001000", "0.00020"},
+		{12.7, "1.00000000", "12"},
+		{0.00029, "0.00010000", "0.0002"},
+	}
+
+	for _, tt := range tests {
+		got, err := floorToStep(tt.quantity, tt.stepSize)
+		if err != nil || got != tt.want {
+			t.Errorf("floorToStep(%v, %s) = %q, %v; want %q", tt.quantity, tt.stepSize, got, err, tt.want)
+		}
+	}
+	if _, err := floorToStep(1, "0"); err == nil {
+		t.Error("Expected a zero step size to be rejected")
+	}
+}
@@ -564,7 +564,7 @@ func TestTravelRuleWithdraw(t *testing.T) {
 		var capturedBody string
 		interceptClient := &http.Client{
 			Transport: &requestInterceptor{
-				RoundTripper: http.DefaultTransport,
+				RoundTripper: newTransport(),
 				interceptFunc: func(req *http.Request) {
 					if req.Body != nil {
 						bodyBytes, _ := io.ReadAll(req.Body)
@@ -519,58 +519,33 @@ func TestDisableFastWithdraw(t *testing.T) {
 	}
 }
 
-// TestGetAPITradingStatus tests getting API trading status
+// TestGetAPITradingStatus tests the SAPI API trading status endpoint, which only exists on production
 func TestGetAPITradingStatus(t *testing.T) {
-	for _, config := range getTestConfigs() {
-		if config.AuthType < AuthTypeUSER_DATA {
-			continue
+	mainnetEndpoint(t, "GetAPITradingStatus", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		resp, httpResp, err := client.WalletAPI.GetAccountApiTradingStatusV1(ctx).
+			Timestamp(generateTimestamp()).
+			RecvWindow(5000).
+			Execute()
+		if err != nil {
+			failSAPI(t, err, httpResp, "GetAPITradingStatus")
 		}
 		
-		t.Run(config.Name, func(t *testing.T) {
-			testEndpoint(t, config, "GetAPITradingStatus", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.WalletAPI.GetAccountApiTradingStatusV1(ctx).
-					Timestamp(generateTimestamp()).
-					RecvWindow(5000)
-				
-				resp, httpResp, err := req.Execute()
-				if err != nil {
-					checkAPIError(t, err)
-					// This endpoint might not be available on testnet
-					if httpResp != nil && httpResp.StatusCode == 404 {
-						t.Skip("API trading status endpoint not available on testnet")
-					}
-					t.Fatalf("Failed to get API trading status: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				// Verify response
-				if resp.Data == nil {
-					t.Error("Expected data in response")
-				} else if resp.Data.IsLocked == nil {
-					t.Error("Expected isLocked status")
-				} else {
-					t.Logf("API trading locked: %v", *resp.Data.IsLocked)
-				}
-				
-				if resp.Data.PlannedRecoverTime != nil && *resp.Data.PlannedRecoverTime > 0 {
-					t.Logf("Planned recover time: %d", *resp.Data.PlannedRecoverTime)
-				}
-				
-				if resp.Data.TriggerCondition != nil {
-					t.Logf("Trigger condition - GCR: %d, IFER: %d, UFR: %d", 
-						resp.Data.TriggerCondition.GCR, 
-						resp.Data.TriggerCondition.IFER, 
-						resp.Data.TriggerCondition.UFR)
-				}
-				
-				if resp.Data.UpdateTime != nil && *resp.Data.UpdateTime > 0 {
-					updateTime := time.Unix(*resp.Data.UpdateTime/1000, 0)
-					t.Logf("Last update: %v", updateTime)
-				}
-			})
-		})
-	}
-}
\ No newline at end of file
+		if resp.Data == nil {
+			t.Fatal("Expected data in API trading status response")
+		}
+		if resp.Data.IsLocked == nil {
+			t.Fatal("Expected isLocked flag")
+		}
+		if resp.Data.PlannedRecoverTime == nil {
+			t.Error("Expected plannedRecoverTime field")
+		} else if *resp.Data.IsLocked && *resp.Data.PlannedRecoverTime <= 0 {
+			t.Errorf("Locked API trading should report a plannedRecoverTime, got %d", *resp.Data.PlannedRecoverTime)
+		}
+		if resp.Data.Indicators == nil {
+			t.Error("Expected indicators object (empty when no rule is triggered)")
+		}
+		
+		t.Logf("API trading locked: %v, planned recover time: %v, indicators: %v",
+			*resp.Data.IsLocked, resp.Data.PlannedRecoverTime, resp.Data.Indicators)
+	})
+}
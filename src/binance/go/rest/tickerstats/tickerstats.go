@@ -0,0 +1,62 @@
+// Package tickerstats checks the 24hr ticker fields that are derived from one another.
+// Spot and both futures products return them as decimal strings with the same meaning, so each
+// REST module passes its SDK's ticker fields here.
+package tickerstats
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ChangePercentTolerance absorbs the API rounding priceChangePercent to a few decimals
+const ChangePercentTolerance = 0.01
+
+// Violations returns a description of every field that is missing, unparseable or inconsistent
+// with the others; a zero openPrice skips the percent check. Tickers with no trades in the window
+// carry placeholder values and should not be passed in.
+func Violations(open, last, high, low, weightedAvg, changePercent *string) []string {
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{"openPrice", open}, {"lastPrice", last}, {"highPrice", high},
+		{"lowPrice", low}, {"weightedAvgPrice", weightedAvg}, {"priceChangePercent", changePercent},
+	}
+	parsed := make(map[string]float64, len(fields))
+	var problems []string
+	for _, f := range fields {
+		if f.value == nil {
+			problems = append(problems, f.name+" is missing")
+			continue
+		}
+		v, err := strconv.ParseFloat(*f.value, 64)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s %q does not parse", f.name, *f.value))
+			continue
+		}
+		parsed[f.name] = v
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+
+	openPrice, lastPrice, highPrice, lowPrice := parsed["openPrice"], parsed["lastPrice"], parsed["highPrice"], parsed["lowPrice"]
+	if openPrice > 0 {
+		want := (lastPrice - openPrice) / openPrice * 100
+		if got := parsed["priceChangePercent"]; math.Abs(got-want) > ChangePercentTolerance {
+			problems = append(problems, fmt.Sprintf("priceChangePercent %.4f, expected %.4f from open %v and last %v", got, want, openPrice, lastPrice))
+		}
+	}
+	if lowPrice > highPrice {
+		problems = append(problems, fmt.Sprintf("lowPrice %v is above highPrice %v", lowPrice, highPrice))
+	}
+	if lastPrice < lowPrice || lastPrice > highPrice {
+		problems = append(problems, fmt.Sprintf("lastPrice %v is outside [lowPrice %v, highPrice %v]", lastPrice, lowPrice, highPrice))
+	}
+	// A window with no trades reports a zero weighted average
+	if avg := parsed["weightedAvgPrice"]; avg != 0 && (avg < lowPrice || avg > highPrice) {
+		problems = append(problems, fmt.Sprintf("weightedAvgPrice %v is outside [lowPrice %v, highPrice %v]", avg, lowPrice, highPrice))
+	}
+	return problems
+}
@@ -0,0 +1,41 @@
+package tickerstats
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestViolations verifies consistent tickers pass and each kind of inconsistency is reported
+func TestViolations(t *testing.T) {
+	s := func(v string) *string { return &v }
+
+	tests := []struct {
+		name string
+		// open, last, high, low, weightedAvg, changePercent
+		fields []*string
+		want   []string
+	}{
+		{"Consistent", []*string{s("100"), s("110"), s("120"), s("90"), s("105"), s("10.000")}, nil},
+		{"ZeroOpenSkipsPercent", []*string{s("0"), s("110"), s("120"), s("90"), s("105"), s("0")}, nil},
+		{"NoTradesZeroAverage", []*string{s("100"), s("110"), s("120"), s("90"), s("0"), s("10")}, nil},
+		{"Missing", []*string{nil, s("110"), s("120"), s("90"), s("105"), s("10")}, []string{"openPrice is missing"}},
+		{"Unparseable", []*string{s("100"), s("x"), s("120"), s("90"), s("105"), s("10")}, []string{"lastPrice \"x\" does not parse"}},
+		{"WrongPercent", []*string{s("100"), s("110"), s("120"), s("90"), s("105"), s("9.5")}, []string{"priceChangePercent"}},
+		{"LowAboveHigh", []*string{s("100"), s("100"), s("90"), s("120"), s("0"), s("0")}, []string{"lowPrice 120 is above highPrice 90", "lastPrice 100 is outside"}},
+		{"AverageOutsideRange", []*string{s("100"), s("110"), s("120"), s("90"), s("130"), s("10")}, []string{"weightedAvgPrice 130 is outside"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Violations(tt.fields[0], tt.fields[1], tt.fields[2], tt.fields[3], tt.fields[4], tt.fields[5])
+			if len(got) != len(tt.want) {
+				t.Fatalf("Violations() = %v, want %d problems matching %v", got, len(tt.want), tt.want)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(got[i], want) {
+					t.Errorf("problem %d = %q, want it to mention %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package timestamp is the request clock shared by the REST product test suites.
+// Each suite's generateTimestamp delegates here, so a server clock offset is applied in one place.
+package timestamp
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// offset is added to the local clock, in milliseconds (server - local)
+var offset atomic.Int64
+
+// Now returns the current time in milliseconds, adjusted by the configured offset
+func Now() int64 {
+	return time.Now().UnixMilli() + offset.Load()
+}
+
+// SetOffset sets the offset in milliseconds added to every timestamp returned by Now
+func SetOffset(ms int64) {
+	offset.Store(ms)
+}
+
+// Sync measures the offset against serverTime, which returns the server's clock in milliseconds,
+// and applies it to Now. The server time is compared against the midpoint of the round trip to
+// cancel out latency. On error the current offset is kept.
+func Sync(serverTime func() (int64, error)) error {
+	before := time.Now().UnixMilli()
+	server, err := serverTime()
+	after := time.Now().UnixMilli()
+	if err != nil {
+		return err
+	}
+
+	SetOffset(server - (before+after)/2)
+	return nil
+}
+
+// Offset returns the offset in milliseconds currently applied by Now
+func Offset() int64 {
+	return offset.Load()
+}
+
+// RecentWindow is how far a server timestamp may lag or lead the synced clock
+const RecentWindow = 10 * time.Second
+
+// CheckRecent returns an error if a server timestamp (ms) is zero, negative or further than
+// RecentWindow from Now
+func CheckRecent(ms int64) error {
+	if ms <= 0 {
+		return fmt.Errorf("is zero or negative: %d", ms)
+	}
+
+	now := Now()
+	diff := now - ms
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > RecentWindow.Milliseconds() {
+		return fmt.Errorf("is not recent: %d (%s), synced now: %d, diff: %d ms, allowed: %v",
+			ms, time.UnixMilli(ms).UTC().Format(time.RFC3339Nano), now, diff, RecentWindow)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestSetOffset verifies Now applies the configured offset to the local clock
+func TestSetOffset(t *testing.T) {
+	t.Cleanup(func() { SetOffset(0) })
+
+	tests := []int64{0, 1500, -2500}
+	for _, ms := range tests {
+		SetOffset(ms)
+		if got := Offset(); got != ms {
+			t.Fatalf("Offset() = %d after SetOffset(%d)", got, ms)
+		}
+
+		before := time.Now().UnixMilli()
+		now := Now()
+		after := time.Now().UnixMilli()
+		if now < before+ms || now > after+ms {
+			t.Errorf("Now() = %d with offset %d, want within [%d, %d]", now, ms, before+ms, after+ms)
+		}
+	}
+}
+
+// TestSync verifies Sync applies the measured server offset and keeps the old one on error
+func TestSync(t *testing.T) {
+	t.Cleanup(func() { SetOffset(0) })
+
+	const ahead = 3000
+	if err := Sync(func() (int64, error) { return time.Now().UnixMilli() + ahead, nil }); err != nil {
+		t.Fatalf("Sync() failed: %v", err)
+	}
+	// The fake server answers instantly, so only scheduling jitter separates the offset from ahead
+	if got := Offset(); got < ahead-50 || got > ahead+50 {
+		t.Errorf("Offset() = %d after Sync with a server %d ms ahead", got, ahead)
+	}
+
+	synced := Offset()
+	if err := Sync(func() (int64, error) { return 0, errors.New("unreachable") }); err == nil {
+		t.Error("Sync() returned nil for a failing server time")
+	}
+	if got := Offset(); got != synced {
+		t.Errorf("Offset() = %d after failed Sync, want unchanged %d", got, synced)
+	}
+}
+
+// TestCheckRecent verifies server timestamps are judged against the synced clock
+func TestCheckRecent(t *testing.T) {
+	t.Cleanup(func() { SetOffset(0) })
+
+	// A server an hour ahead: its timestamps are recent only once the offset is applied
+	const ahead = int64(time.Hour / time.Millisecond)
+	serverNow := time.Now().UnixMilli() + ahead
+	if err := CheckRecent(serverNow); err == nil {
+		t.Error("CheckRecent() accepted a timestamp an hour ahead of an unsynced clock")
+	}
+	SetOffset(ahead)
+	if err := CheckRecent(serverNow); err != nil {
+		t.Errorf("CheckRecent() rejected a timestamp from the synced server: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ms   int64
+	}{
+		{"Zero", 0},
+		{"Negative", -1},
+		{"TooOld", serverNow - 2*RecentWindow.Milliseconds()},
+		{"TooNew", serverNow + 2*RecentWindow.Milliseconds()},
+	}
+	for _, tt := range tests {
+		if err := CheckRecent(tt.ms); err == nil {
+			t.Errorf("%s: CheckRecent(%d) returned nil", tt.name, tt.ms)
+		}
+	}
+}
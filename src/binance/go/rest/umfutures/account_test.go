@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	openapi "github.com/openxapi/binance-go/rest/umfutures"
+)
+
+// TestLeverageBracket tests leverage bracket field completeness and ordering for the test symbol
+func TestLeverageBracket(t *testing.T) {
+	testEndpoint(t, AuthTypeUSER_DATA, "LeverageBracket", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		symbol := getTestSymbol()
+		
+		resp, _, err := client.FuturesAPI.GetLeverageBracketV1(ctx).
+			Symbol(symbol).
+			Timestamp(generateTimestamp()).
+			Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			t.Fatalf("Leverage bracket failed: %v", err)
+		}
+		
+		if len(resp) == 0 || len(resp[0].Brackets) == 0 {
+			t.Skipf("No leverage brackets returned for %s", symbol)
+		}
+		
+		entry := resp[0]
+		if entry.Symbol != nil && *entry.Symbol != symbol {
+			t.Errorf("Expected brackets for %s, got %s", symbol, *entry.Symbol)
+		}
+		
+		var prevCap, prevFloor, prevRatio float64
+		for i, bracket := range entry.Brackets {
+			if bracket.Bracket == nil || bracket.InitialLeverage == nil || bracket.NotionalCap == nil ||
+				bracket.NotionalFloor == nil || bracket.MaintMarginRatio == nil {
+				t.Fatalf("Bracket %d is missing bracket, initialLeverage, notionalCap, notionalFloor or maintMarginRatio", i)
+			}
+			
+			if int(*bracket.Bracket) != i+1 {
+				t.Errorf("Bracket %d has bracket number %d, want %d", i, *bracket.Bracket, i+1)
+			}
+			
+			floor := float64(*bracket.NotionalFloor)
+			notionalCap := float64(*bracket.NotionalCap)
+			ratio := float64(*bracket.MaintMarginRatio)
+			
+			if floor >= notionalCap {
+				t.Errorf("Bracket %d has notionalFloor %v >= notionalCap %v", i+1, floor, notionalCap)
+			}
+			if *bracket.InitialLeverage <= 0 {
+				t.Errorf("Bracket %d has non-positive initialLeverage %d", i+1, *bracket.InitialLeverage)
+			}
+			
+			if i > 0 {
+				if floor < prevFloor || notionalCap <= prevCap {
+					t.Errorf("Bracket %d is not ordered by notional: floor %v/cap %v after floor %v/cap %v",
+						i+1, floor, notionalCap, prevFloor, prevCap)
+				}
+				if ratio <= prevRatio {
+					t.Errorf("Bracket %d maintMarginRatio %v does not increase over previous %v", i+1, ratio, prevRatio)
+				}
+			}
+			prevCap, prevFloor, prevRatio = notionalCap, floor, ratio
+		}
+		
+		// The first bracket carries the highest leverage the symbol allows
+		t.Logf("%s: %d leverage brackets, max leverage %dx", symbol, len(entry.Brackets), *entry.Brackets[0].InitialLeverage)
+	})
+}
+
+// positionMarginAmount is the USDT amount added to and then removed from the isolated position
+const positionMarginAmount = 5.0
+
+// getIsolatedPosition returns the position amount and isolated wallet balance for symbol
+func getIsolatedPosition(client *openapi.APIClient, ctx context.Context, symbol string) (float64, float64, error) {
+	resp, _, err := client.FuturesAPI.GetPositionRiskV2(ctx).
+		Symbol(symbol).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return 0, 0, err
+	}
+	
+	for _, position := range resp {
+		if position.Symbol == nil || *position.Symbol != symbol || position.PositionAmt == nil {
+			continue
+		}
+		amount, err := strconv.ParseFloat(*position.PositionAmt, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid positionAmt %q: %w", *position.PositionAmt, err)
+		}
+		if amount == 0 {
+			continue
+		}
+		// isolatedWallet excludes unrealized PnL, so it moves only with margin transfers
+		var wallet float64
+		if position.IsolatedWallet != nil {
+			wallet, err = strconv.ParseFloat(*position.IsolatedWallet, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid isolatedWallet %q: %w", *position.IsolatedWallet, err)
+			}
+		}
+		return amount, wallet, nil
+	}
+	return 0, 0, nil
+}
+
+// getMarginType returns the margin type of symbol as CreateMarginTypeV1 expects it: ISOLATED or CROSSED
+func getMarginType(client *openapi.APIClient, ctx context.Context, symbol string) (string, error) {
+	resp, _, err := client.FuturesAPI.GetPositionRiskV2(ctx).
+		Symbol(symbol).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		return "", err
+	}
+	
+	for _, position := range resp {
+		if position.Symbol == nil || *position.Symbol != symbol || position.MarginType == nil {
+			continue
+		}
+		// Position risk reports "isolated" or "cross"
+		if strings.EqualFold(*position.MarginType, "isolated") {
+			return "ISOLATED", nil
+		}
+		return "CROSSED", nil
+	}
+	return "", fmt.Errorf("no position risk entry for %s", symbol)
+}
+
+// flattenPosition closes any open position on symbol with a reduce-only market order
+func flattenPosition(t *testing.T, client *openapi.APIClient, ctx context.Context, symbol string) {
+	amount, _, err := getIsolatedPosition(client, ctx, symbol)
+	if err != nil {
+		t.Logf("Warning: Failed to read position for cleanup: %v", err)
+		return
+	}
+	if amount == 0 {
+		return
+	}
+	
+	side := "SELL"
+	if amount < 0 {
+		side = "BUY"
+	}
+	resp, _, err := client.FuturesAPI.CreateOrderV1(ctx).
+		Symbol(symbol).
+		Side(side).
+		Type_("MARKET").
+		Quantity(strconv.FormatFloat(math.Abs(amount), 'f', -1, 64)).
+		ReduceOnly("true").
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		checkAPIError(t, err)
+		t.Logf("Warning: Failed to flatten %s position: %v", symbol, err)
+		return
+	}
+	if resp.OrderId != nil {
+		t.Logf("Flattened %s position of %v with order %d", symbol, amount, *resp.OrderId)
+	}
+}
+
+// isPositionMarginBusinessError reports whether err is an expected rejection for a
+// cross-margin or empty position rather than an API failure
+func isPositionMarginBusinessError(err error) (string, bool) {
+	apiErr, ok := err.(openapi.GenericOpenAPIError)
+	if !ok {
+		return "", false
+	}
+	body := string(apiErr.Body())
+	if strings.Contains(body, "only support for isolated position") || strings.Contains(body, "position is 0") {
+		return body, true
+	}
+	return body, false
+}
+
+// modifyPositionMargin adds (type 1) or reduces (type 2) isolated margin and returns
+// the change in the isolated wallet observed through GetPositionRiskV2
+func modifyPositionMargin(t *testing.T, client *openapi.APIClient, ctx context.Context, symbol string, marginType int32) (float64, bool) {
+	_, before, err := getIsolatedPosition(client, ctx, symbol)
+	if err != nil {
+		checkAPIError(t, err)
+		t.Fatalf("Failed to read isolated margin before modification: %v", err)
+	}
+	
+	resp, _, err := client.FuturesAPI.CreatePositionMarginV1(ctx).
+		Symbol(symbol).
+		Amount(strconv.FormatFloat(positionMarginAmount, 'f', -1, 64)).
+		Type_(marginType).
+		Timestamp(generateTimestamp()).
+		Execute()
+	if err != nil {
+		if body, ok := isPositionMarginBusinessError(err); ok {
+			t.Logf("PositionMargin API is working correctly - rejected with business error: %s", body)
+			return 0, false
+		}
+		checkAPIError(t, err)
+		t.Fatalf("Position margin (type %d) failed: %v", marginType, err)
+	}
+	
+	if resp.Code == nil || resp.Amount == nil || resp.Type == nil {
+		t.Fatal("Position margin response is missing code, amount or type")
+	}
+	if *resp.Type != marginType {
+		t.Errorf("Expected type %d in response, got %d", marginType, *resp.Type)
+	}
+	
+	// Give the position snapshot a moment to reflect the transfer
+	time.Sleep(1 * time.Second)
+	
+	_, after, err := getIsolatedPosition(client, ctx, symbol)
+	if err != nil {
+		checkAPIError(t, err)
+		t.Fatalf("Failed to read isolated margin after modification: %v", err)
+	}
+	t.Logf("Position margin type %d: code=%d, amount=%v, isolatedWallet %v -> %v", marginType, *resp.Code, *resp.Amount, before, after)
+	return after - before, true
+}
+
+// TestPositionMargin tests adding and reducing isolated position margin and verifies the effect
+func TestPositionMargin(t *testing.T) {
+	skipIfReadOnly(t)
+	// Skip if position margin modification is not enabled
+	if os.Getenv("BINANCE_TEST_UMFUTURES_POSITION_MARGIN") != "true" {
+		t.Skip("Position margin modification disabled. Set BINANCE_TEST_UMFUTURES_POSITION_MARGIN=true to enable")
+	}
+
+	testEndpoint(t, AuthTypeTRADE, "PositionMargin", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		symbol := getTestSymbol()
+		requireTrading(t, symbol)
+		
+		// Margin type can only change while the symbol has no position
+		flattenPosition(t, client, ctx, symbol)
+		
+		originalMarginType, err := getMarginType(client, ctx, symbol)
+		if err != nil {
+			checkAPIError(t, err)
+			t.Fatalf("Failed to read margin type for %s: %v", symbol, err)
+		}
+		if originalMarginType != "ISOLATED" {
+			_, _, err := client.FuturesAPI.CreateMarginTypeV1(ctx).
+				Symbol(symbol).
+				MarginType("ISOLATED").
+				Timestamp(generateTimestamp()).
+				Execute()
+			if err != nil {
+				checkAPIError(t, err)
+				t.Fatalf("Failed to switch %s to ISOLATED: %v", symbol, err)
+			}
+			// Runs after the position is flattened, since margin type cannot change with one open
+			defer func() {
+				_, _, err := client.FuturesAPI.CreateMarginTypeV1(ctx).
+					Symbol(symbol).
+					MarginType(originalMarginType).
+					Timestamp(generateTimestamp()).
+					Execute()
+				if err != nil {
+					t.Logf("Warning: Failed to restore %s margin type to %s: %v", symbol, originalMarginType, err)
+				}
+			}()
+		}
+		
+		currentPrice, err := getCurrentPrice(client, ctx, symbol)
+		if err != nil {
+			t.Fatalf("Failed to get current price: %v", err)
+		}
+		
+		// Size the position just above the minimum notional
+		quantity := fmt.Sprintf("%.3f", math.Ceil(120/currentPrice*1000)/1000)
+		orderResp, _, err := client.FuturesAPI.CreateOrderV1(ctx).
+			Symbol(symbol).
+			Side("BUY").
+			Type_("MARKET").
+			Quantity(quantity).
+			Timestamp(generateTimestamp()).
+			Execute()
+		if err != nil {
+			checkAPIError(t, err)
+			t.Fatalf("Failed to open position: %v", err)
+		}
+		defer flattenPosition(t, client, ctx, symbol)
+		
+		if orderResp.OrderId != nil {
+			t.Logf("Opened %s position of %s with order %d", symbol, quantity, *orderResp.OrderId)
+		}
+		
+		// Wait for the market order to be reflected in position risk
+		time.Sleep(2 * time.Second)
+		
+		added, ok := modifyPositionMargin(t, client, ctx, symbol, 1)
+		if !ok {
+			return
+		}
+		if added <= 0 {
+			t.Errorf("Expected isolated margin to increase after adding %v, changed by %v", positionMarginAmount, added)
+		}
+		
+		reduced, ok := modifyPositionMargin(t, client, ctx, symbol, 2)
+		if !ok {
+			return
+		}
+		if reduced >= 0 {
+			t.Errorf("Expected isolated margin to decrease after reducing %v, changed by %v", positionMarginAmount, reduced)
+		}
+	})
+}
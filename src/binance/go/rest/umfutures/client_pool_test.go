@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	openapi "github.com/openxapi/binance-go/rest/umfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/clientpool"
+)
+
+// clientPoolHTTPTimeout bounds every request made through a pooled client
+const clientPoolHTTPTimeout = 30 * time.Second
+
+// clientPoolKey identifies a pooled client by auth configuration
+type clientPoolKey struct {
+	name     string
+	authType AuthType
+	signType string
+	apiKey   string
+}
+
+// pooledClient is a shared client together with its authenticated context
+type pooledClient struct {
+	client *openapi.APIClient
+	ctx    context.Context
+}
+
+// testClientPool holds one client per config for the whole test binary
+var testClientPool = clientpool.New[clientPoolKey, pooledClient]()
+
+// pooledClientFor returns the shared client for config, building it on first use. Every test
+// using the config gets the same client, so a test that changes the configuration must copy it.
+func pooledClientFor(config TestConfig) (*openapi.APIClient, context.Context) {
+	key := clientPoolKey{
+		name:     config.Name,
+		authType: config.AuthType,
+		signType: config.SignType,
+		apiKey:   config.APIKey,
+	}
+	pooled := testClientPool.Get(key, func() pooledClient {
+		client, ctx := setupClient(config)
+		// setupClient gave the config its own HTTP client, so the timeout is set once here
+		client.GetConfig().HTTPClient.Timeout = clientPoolHTTPTimeout
+		return pooledClient{client: client, ctx: ctx}
+	})
+	return pooled.client, pooled.ctx
+}
+
+// TestClientPool tests that the same config returns the same client and different configs distinct ones
+func TestClientPool(t *testing.T) {
+	hmac := TestConfig{Name: "Pool HMAC", APIKey: "key-a", SecretKey: "secret-a", SignType: "HMAC", AuthType: AuthTypeTRADE}
+	public := TestConfig{Name: "Pool Public", AuthType: AuthTypeNONE}
+
+	first, _ := pooledClientFor(hmac)
+	second, _ := pooledClientFor(hmac)
+	if first != second {
+		t.Error("Expected the same client for the same config")
+	}
+	if first.GetConfig().HTTPClient.Timeout != clientPoolHTTPTimeout {
+		t.Errorf("Expected the pooled client timeout %v, got %v", clientPoolHTTPTimeout, first.GetConfig().HTTPClient.Timeout)
+	}
+
+	other, _ := pooledClientFor(public)
+	if other == first {
+		t.Error("Expected distinct clients for different configs")
+	}
+}
@@ -10,14 +10,17 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
 	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/umfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/timestamp"
 )
 
 // AuthType represents the type of authentication
@@ -70,7 +73,7 @@ func getTestConfigs() []TestConfig {
 	var configs []TestConfig
 
 	// Check if we should test all auth types (for comprehensive testing)
-	testAllAuth := os.Getenv("TEST_ALL_AUTH_TYPES") == "true"
+	testAllAuth := os.Getenv("TEST_ALL_AUTH_TYPES") == "true" || allAuthMode()
 
 	if testAllAuth {
 		// HMAC configuration
@@ -145,6 +148,9 @@ func setupClient(config TestConfig) (*openapi.APIClient, context.Context) {
 		},
 	}
 
+	// Each client owns its transport, routed through BINANCE_TEST_HTTP_PROXY when set
+	cfg.HTTPClient = &http.Client{Transport: newTransport()}
+
 	// Create client
 	client := openapi.NewAPIClient(cfg)
 	ctx := context.Background()
@@ -243,18 +249,44 @@ func loadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
 	return nil, errors.New("invalid Ed25519 private key format")
 }
 
-// testEndpoint is a helper to test an endpoint with proper setup and teardown
-func testEndpoint(t *testing.T, config TestConfig, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
-	rateLimiter.WaitForRateLimit()
+// testEndpoint runs testFunc as a sub-test against each config providing the required auth,
+// with proper setup and teardown
+func testEndpoint(t *testing.T, required AuthType, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
+	for _, config := range matchingConfigs(getTestConfigs(), required) {
+		t.Run(config.Name, func(t *testing.T) {
+			checkBudget(t)
+			rateLimiter.WaitForRateLimit()
 
-	client, ctx := setupClient(config)
-	
-	// Create a context with timeout for the HTTP requests
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-	
-	// Run test function directly - t.Fatal will properly fail the test immediately
-	testFunc(t, client, timeoutCtx)
+			client, ctx := pooledClientFor(config)
+			
+			// Create a context with timeout for the HTTP requests
+			timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			defer cancel()
+			
+			// Run test function directly - t.Fatal will properly fail the test immediately
+			testFunc(t, client, timeoutCtx)
+		})
+	}
+}
+
+// matchingConfigs returns the configs a test requiring the given auth runs against: public
+// tests use the public config only, others any config with at least that permission. Only
+// the first match is kept unless BINANCE_TEST_ALL_AUTH=true, to limit request weight.
+func matchingConfigs(configs []TestConfig, required AuthType) []TestConfig {
+	var matched []TestConfig
+	for _, config := range configs {
+		if required == AuthTypeNONE && config.AuthType != AuthTypeNONE {
+			continue
+		}
+		if config.AuthType < required {
+			continue
+		}
+		matched = append(matched, config)
+		if !allAuthMode() {
+			break
+		}
+	}
+	return matched
 }
 
 // getCurrentPrice fetches the current price for a symbol using a public (non-authenticated) client
@@ -317,9 +349,329 @@ func checkAPIError(t *testing.T, err error) {
 	}
 }
 
-// generateTimestamp generates a timestamp for API requests
+// apiErrorCode extracts the Binance error code from an SDK error, if it carries one
+func apiErrorCode(err error) (int64, bool) {
+	var model interface{}
+	switch apiErr := err.(type) {
+	case *openapi.GenericOpenAPIError:
+		model = apiErr.Model()
+	case openapi.GenericOpenAPIError:
+		model = apiErr.Model()
+	default:
+		return 0, false
+	}
+	if apiError, ok := model.(*openapi.APIError); ok && apiError.Code != nil {
+		return int64(*apiError.Code), true
+	}
+	return 0, false
+}
+
+// generateTimestamp generates a timestamp for API requests from the shared request clock
 func generateTimestamp() int64 {
-	return time.Now().UnixMilli()
+	return timestamp.Now()
+}
+
+// syncRequestClock aligns the shared request clock with the configured server, so signed requests
+// are not rejected for clock drift (-1021). The local clock is kept if the server time is unavailable.
+func syncRequestClock() {
+	client, ctx := setupClient(TestConfig{Name: "Request Clock"})
+	err := timestamp.Sync(func() (int64, error) {
+		resp, _, err := client.FuturesAPI.GetTimeV1(ctx).Execute()
+		if err != nil {
+			return 0, err
+		}
+		if resp.ServerTime == nil {
+			return 0, fmt.Errorf("response has no serverTime")
+		}
+		return *resp.ServerTime, nil
+	})
+	if err != nil {
+		fmt.Printf("Using the local clock for request timestamps: %v\n", err)
+		return
+	}
+	fmt.Printf("Request clock offset from server: %d ms\n", timestamp.Offset())
+}
+
+// assertEmptySlice fails the test if an endpoint documented to return a JSON array gave back nil.
+// Decoding "[]" yields a non-nil empty slice, so nil means the body was null or never decoded.
+func assertEmptySlice[T any](t *testing.T, resp []T, name string) {
+	t.Helper()
+	if resp == nil {
+		t.Errorf("%s returned a nil %T instead of an empty slice; the response was not decoded as an array", name, resp)
+	}
+}
+
+// assertRecentServerTime fails the test if a server timestamp (ms) is zero or not close to the
+// synced request clock
+func assertRecentServerTime(t *testing.T, ms int64, field string) {
+	t.Helper()
+	if err := timestamp.CheckRecent(ms); err != nil {
+		t.Fatalf("%s %v", field, err)
+	}
+}
+
+// batchTooLargeCode is the error (-1130, invalid parameter) returned for a batch over maxBatchOrders;
+// its message names the batchOrders parameter
+const batchTooLargeCode = -1130
+
+// DefaultUMFuturesSymbol is the contract used when BINANCE_TEST_UMFUTURES_SYMBOL is unset
+const DefaultUMFuturesSymbol = "BTCUSDT"
+
+// strictEnvVar promotes selected warnings to failures when set to "true"
+const strictEnvVar = "BINANCE_TEST_STRICT"
+
+// strictMode reports whether warnings raised through warnf should fail the test
+func strictMode() bool {
+	return os.Getenv(strictEnvVar) == "true"
+}
+
+// warnf reports a condition that is tolerated locally but should fail strict (CI) runs
+func warnf(t testing.TB, format string, args ...interface{}) {
+	t.Helper()
+	if strictMode() {
+		t.Errorf("[strict] "+format, args...)
+		return
+	}
+	t.Logf("⚠️  Warning: "+format, args...)
+}
+
+// warnRecorder captures warnf output without failing the enclosing test
+type warnRecorder struct {
+	testing.TB
+	errors []string
+	logs   []string
+}
+
+func (r *warnRecorder) Helper() {}
+
+func (r *warnRecorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *warnRecorder) Logf(format string, args ...interface{}) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+// TestWarnfStrictMode tests that warnf only fails the test in strict mode
+func TestWarnfStrictMode(t *testing.T) {
+	t.Run("Lenient", func(t *testing.T) {
+		t.Setenv(strictEnvVar, "")
+		r := &warnRecorder{TB: t}
+		warnf(r, "price mismatch: %s != %s", "1.0", "1.1")
+		if len(r.errors) != 0 {
+			t.Errorf("Expected no failures in lenient mode, got %v", r.errors)
+		}
+		if len(r.logs) != 1 || !strings.Contains(r.logs[0], "price mismatch: 1.0 != 1.1") {
+			t.Errorf("Expected the warning to be logged, got %v", r.logs)
+		}
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		t.Setenv(strictEnvVar, "true")
+		r := &warnRecorder{TB: t}
+		warnf(r, "no events recorded for %s", "aggTrade")
+		if len(r.errors) != 1 || !strings.Contains(r.errors[0], "no events recorded for aggTrade") {
+			t.Errorf("Expected the warning to become a failure in strict mode, got %v", r.errors)
+		}
+		if len(r.logs) != 0 {
+			t.Errorf("Expected no plain log in strict mode, got %v", r.logs)
+		}
+	})
+}
+
+// clientOrderPrefixEnvVar overrides the prefix stamped on every clientOrderId the suite creates
+const clientOrderPrefixEnvVar = "BINANCE_TEST_CLIENT_ORDER_PREFIX"
+
+// DefaultClientOrderPrefix marks orders created by this suite so leaked ones can be found
+const DefaultClientOrderPrefix = "test_"
+
+// clientOrderPrefix returns the prefix used for suite-created client order IDs
+func clientOrderPrefix() string {
+	if prefix := os.Getenv(clientOrderPrefixEnvVar); prefix != "" {
+		return prefix
+	}
+	return DefaultClientOrderPrefix
+}
+
+// newClientOrderId returns a unique, prefixed client order ID for tag; a base36
+// nanosecond suffix keeps it within the 36 character limit
+func newClientOrderId(tag string) string {
+	return clientOrderPrefix() + tag + "_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+}
+
+// allAuthEnvVar runs every test against all configured auth methods when set to "true"
+const allAuthEnvVar = "BINANCE_TEST_ALL_AUTH"
+
+// allAuthMode reports whether HMAC, RSA and Ed25519 configs should all be exercised
+func allAuthMode() bool {
+	return os.Getenv(allAuthEnvVar) == "true"
+}
+
+// readOnlyEnvVar skips every test that needs TRADE permission when set to "true",
+// so read-only API keys can validate the query endpoints
+const readOnlyEnvVar = "BINANCE_TEST_READONLY"
+
+// readOnlyMode reports whether tests requiring TRADE permission should be skipped
+func readOnlyMode() bool {
+	return os.Getenv(readOnlyEnvVar) == "true"
+}
+
+// readOnlyBlocks reports whether a test needing the given permission is skipped in read-only mode
+func readOnlyBlocks(required AuthType) bool {
+	return readOnlyMode() && required >= AuthTypeTRADE
+}
+
+// skipIfReadOnly guards tests that place, modify or cancel orders or change account settings
+func skipIfReadOnly(t *testing.T) {
+	t.Helper()
+	if readOnlyBlocks(AuthTypeTRADE) {
+		t.Skipf("skipped: read-only mode (%s=true)", readOnlyEnvVar)
+	}
+}
+
+// budgetEnvVar sets an optional wall-clock budget for the whole run, e.g. "10m"
+const budgetEnvVar = "BINANCE_TEST_BUDGET"
+
+// testBudget tracks elapsed run time against an optional limit; a zero limit is unlimited
+type testBudget struct {
+	start time.Time
+	limit time.Duration
+}
+
+// runBudget is the process-wide budget configured by TestMain
+var runBudget = testBudget{start: time.Now()}
+
+// loadTestBudget parses BINANCE_TEST_BUDGET, returning zero when unset
+func loadTestBudget() (time.Duration, error) {
+	value := os.Getenv(budgetEnvVar)
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s=%q is not a valid duration: %w", budgetEnvVar, value, err)
+	}
+	if limit <= 0 {
+		return 0, fmt.Errorf("%s=%q must be positive", budgetEnvVar, value)
+	}
+	return limit, nil
+}
+
+// exhausted reports whether the budget has been used up at now
+func (b testBudget) exhausted(now time.Time) bool {
+	return b.limit > 0 && now.Sub(b.start) >= b.limit
+}
+
+// checkBudget skips a not-yet-started test once the run's time budget is exhausted
+func checkBudget(t *testing.T) {
+	t.Helper()
+	now := time.Now()
+	if runBudget.exhausted(now) {
+		t.Skipf("time budget exhausted: %v elapsed of %v (%s)",
+			now.Sub(runBudget.start).Round(time.Second), runBudget.limit, budgetEnvVar)
+	}
+}
+
+// getTestSymbol returns the symbol used by market data and trading tests
+func getTestSymbol() string {
+	if symbol := os.Getenv("BINANCE_TEST_UMFUTURES_SYMBOL"); symbol != "" {
+		return symbol
+	}
+	return DefaultUMFuturesSymbol
+}
+
+// validateSymbolStatus checks that symbol is listed and currently TRADING.
+// statuses maps each exchangeInfo symbol to its status.
+func validateSymbolStatus(envVar, symbol string, statuses map[string]string) error {
+	status, ok := statuses[symbol]
+	if !ok {
+		return fmt.Errorf("%s=%q is not listed in exchangeInfo; unset it or choose an existing USD-M symbol", envVar, symbol)
+	}
+	if status != "TRADING" {
+		return fmt.Errorf("%s=%q has status %s; choose a symbol with status TRADING", envVar, symbol, status)
+	}
+	return nil
+}
+
+// validateConfiguredSymbol verifies a user-supplied BINANCE_TEST_UMFUTURES_SYMBOL
+// against exchangeInfo. The hardcoded default is not checked.
+func validateConfiguredSymbol() error {
+	symbol := os.Getenv("BINANCE_TEST_UMFUTURES_SYMBOL")
+	if symbol == "" {
+		return nil
+	}
+
+	statuses, err := symbolStatuses()
+	if err != nil {
+		// The exchange may be unreachable; let the tests themselves report that
+		fmt.Printf("Warning: could not validate BINANCE_TEST_UMFUTURES_SYMBOL=%q: %v\n", symbol, err)
+		return nil
+	}
+	return validateSymbolStatus("BINANCE_TEST_UMFUTURES_SYMBOL", symbol, statuses)
+}
+
+// fetchSymbolStatuses maps each exchangeInfo symbol to its status using a public client on the configured server
+func fetchSymbolStatuses(ctx context.Context) (map[string]string, error) {
+	publicClient, _ := setupClient(TestConfig{Name: "Symbol Status", AuthType: AuthTypeNONE})
+
+	resp, _, err := publicClient.FuturesAPI.GetExchangeInfoV1(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string, len(resp.Symbols))
+	for _, s := range resp.Symbols {
+		if s.Symbol != nil && s.Status != nil {
+			statuses[*s.Symbol] = *s.Status
+		}
+	}
+	return statuses, nil
+}
+
+// Symbol statuses are fetched once per run and shared by every status check
+var (
+	symbolStatusesOnce sync.Once
+	symbolStatusesMap  map[string]string
+	symbolStatusesErr  error
+)
+
+// symbolStatuses returns the exchangeInfo statuses, fetching them on first use. A failed fetch
+// is cached too, so an unreachable exchange costs one timeout rather than one per test.
+func symbolStatuses() (map[string]string, error) {
+	symbolStatusesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		symbolStatusesMap, symbolStatusesErr = fetchSymbolStatuses(ctx)
+	})
+	return symbolStatusesMap, symbolStatusesErr
+}
+
+// nonTradingReason explains why symbol cannot take orders, or returns "" when it is TRADING.
+// statuses maps each exchangeInfo symbol to its status.
+func nonTradingReason(symbol string, statuses map[string]string) string {
+	status, ok := statuses[symbol]
+	if !ok {
+		return fmt.Sprintf("%s is not listed in exchangeInfo", symbol)
+	}
+	if status != "TRADING" {
+		return fmt.Sprintf("%s has market status %s, not TRADING; skipping as a market-state issue, not an SDK bug", symbol, status)
+	}
+	return ""
+}
+
+// requireTrading skips the test when symbol is not currently TRADING (e.g. SETTLING, PENDING_TRADING).
+// If exchangeInfo cannot be fetched the test continues and reports its own failure.
+func requireTrading(t *testing.T, symbol string) {
+	t.Helper()
+
+	statuses, err := symbolStatuses()
+	if err != nil {
+		t.Logf("Warning: could not check market status of %s: %v", symbol, err)
+		return
+	}
+	if reason := nonTradingReason(symbol, statuses); reason != "" {
+		t.Skip(reason)
+	}
 }
 
 // TestFullIntegrationSuite runs all integration tests
@@ -340,26 +692,32 @@ func TestFullIntegrationSuite(t *testing.T) {
 		suite.TotalTests++
 		
 		// Check if we have necessary auth for this test
-		configs := getTestConfigs()
-		canRun := false
-		
-		if test.AuthRequired == AuthTypeNONE {
-			canRun = true
-		} else {
-			for _, config := range configs {
-				if config.AuthType >= test.AuthRequired {
-					canRun = true
-					break
-				}
+		if len(matchingConfigs(getTestConfigs(), test.AuthRequired)) == 0 {
+			fmt.Printf("⚠️  SKIP %s - No authentication configured\n", test.Name)
+			suite.Results[test.Name] = TestResult{
+				Passed:   false,
+				Duration: 0,
+				Error:    errors.New("skipped - no authentication"),
 			}
+			continue
 		}
 
-		if !canRun {
-			fmt.Printf("⚠️  SKIP %s - No authentication configured\n", test.Name)
+		if readOnlyBlocks(test.AuthRequired) {
+			fmt.Printf("⚠️  SKIP %s - read-only mode\n", test.Name)
 			suite.Results[test.Name] = TestResult{
 				Passed:   false,
 				Duration: 0,
-				Error:    errors.New("skipped - no authentication"),
+				Error:    errors.New("skipped - read-only mode"),
+			}
+			continue
+		}
+
+		if runBudget.exhausted(time.Now()) {
+			fmt.Printf("⚠️  SKIP %s - time budget exhausted\n", test.Name)
+			suite.Results[test.Name] = TestResult{
+				Passed:   false,
+				Duration: 0,
+				Error:    errors.New("skipped - time budget exhausted"),
 			}
 			continue
 		}
@@ -410,6 +768,7 @@ func (suite *TestSuite) initializeTests() {
 	suite.Tests = []TestInfo{
 		// Public API Tests
 		{Name: "Exchange Info", Function: TestExchangeInfo, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Delivery Contracts", Function: TestDeliveryContracts, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Server Time", Function: TestServerTime, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Ping", Function: TestPing, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Order Book", Function: TestOrderBook, AuthRequired: AuthTypeNONE, Category: "Public"},
@@ -424,7 +783,7 @@ func (suite *TestSuite) initializeTests() {
 		{Name: "Funding Rate", Function: TestFundingRate, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Funding Info", Function: TestFundingInfo, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Index Info", Function: TestIndexInfo, AuthRequired: AuthTypeNONE, Category: "Public"},
-		{Name: "Constituents", Function: TestConstituents, AuthRequired: AuthTypeNONE, Category: "Public"},
+		{Name: "Index Constituents", Function: TestIndexConstituents, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Asset Index", Function: TestAssetIndex, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Continuous Klines", Function: TestContinuousKlines, AuthRequired: AuthTypeNONE, Category: "Public"},
 		{Name: "Index Price Klines", Function: TestIndexPriceKlines, AuthRequired: AuthTypeNONE, Category: "Public"},
@@ -465,31 +824,37 @@ func (suite *TestSuite) initializeTests() {
 		// {Name: "Commission Rate", Function: TestCommissionRate, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "API Trading Status", Function: TestAPITradingStatus, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Symbol Config", Function: TestSymbolConfig, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
-		// {Name: "Leverage Bracket", Function: TestLeverageBracket, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
+		{Name: "Leverage Bracket", Function: TestLeverageBracket, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Position Side Dual", Function: TestPositionSideDual, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Multi Assets Margin", Function: TestMultiAssetsMargin, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Fee Burn", Function: TestFeeBurn, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Position Margin History", Function: TestPositionMarginHistory, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "Order Amendment", Function: TestOrderAmendment, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
-		// {Name: "Rate Limit Order", Function: TestRateLimitOrder, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		// {Name: "PM Account Info", Function: TestPMAccountInfo, AuthRequired: AuthTypeUSER_DATA, Category: "Account"},
 		
 		// Trading API Tests
 		{Name: "Create Order", Function: TestCreateOrder, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Invalid Order Inputs", Function: TestInvalidOrderInputs, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Get Order", Function: TestGetOrder, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Cancel Order", Function: TestCancelOrder, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Update Order", Function: TestUpdateOrder, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Batch Orders", Function: TestBatchOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Batch Order Limit", Function: TestBatchOrderLimit, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Batch Update Orders", Function: TestBatchUpdateOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Batch Cancel Orders", Function: TestBatchCancelOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Batch Cancel OrderIdList", Function: TestBatchCancelOrderIdList, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "All Orders", Function: TestAllOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "All Orders Pagination", Function: TestAllOrdersPagination, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Open Orders", Function: TestOpenOrders, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "Rate Limit Order", Function: TestRateLimitOrder, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		{Name: "Cancel All Orders", Function: TestCancelAllOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "User Trades", Function: TestUserTrades, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "User Trades By OrderId", Function: TestUserTradesByOrderId, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		{Name: "Commission Rate", Function: TestCommissionRate, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
+		{Name: "Commission Rate Multi Symbol", Function: TestCommissionRateMultiSymbol, AuthRequired: AuthTypeUSER_DATA, Category: "Trading"},
 		// {Name: "Change Leverage", Function: TestChangeLeverage, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		// {Name: "Change Margin Type", Function: TestChangeMarginType, AuthRequired: AuthTypeTRADE, Category: "Trading"},
-		// {Name: "Position Margin", Function: TestPositionMargin, AuthRequired: AuthTypeTRADE, Category: "Trading"},
+		{Name: "Position Margin", Function: TestPositionMargin, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		// {Name: "Change Position Mode", Function: TestChangePositionMode, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		// {Name: "Change Multi Assets Margin", Function: TestChangeMultiAssetsMargin, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 		// {Name: "Change Fee Burn", Function: TestChangeFeeBurn, AuthRequired: AuthTypeTRADE, Category: "Trading"},
@@ -512,6 +877,9 @@ func (suite *TestSuite) initializeTests() {
 		// {Name: "Income Async Download", Function: TestIncomeAsyncDownload, AuthRequired: AuthTypeUSER_DATA, Category: "Async"},
 		// {Name: "Order Async Download", Function: TestOrderAsyncDownload, AuthRequired: AuthTypeUSER_DATA, Category: "Async"},
 		// {Name: "Trade Async Download", Function: TestTradeAsyncDownload, AuthRequired: AuthTypeUSER_DATA, Category: "Async"},
+		
+		// Must stay last: catches orders left open by any earlier test's cleanup
+		{Name: "No Leaked Orders", Function: TestNoLeakedOrders, AuthRequired: AuthTypeTRADE, Category: "Trading"},
 	}
 }
 
@@ -542,10 +910,11 @@ func (suite *TestSuite) printSummary() {
 
 // getTestClient returns a configured test client for individual test files
 func getTestClient(t *testing.T) *openapi.APIClient {
+	checkBudget(t)
 	configs := getTestConfigs()
 	for _, config := range configs {
 		if config.AuthType == AuthTypeTRADE {
-			client, _ := setupClient(config)
+			client, _ := pooledClientFor(config)
 			return client
 		}
 	}
@@ -15,6 +15,34 @@ func TestMain(m *testing.M) {
 	fmt.Println("Using testnet server by default")
 	fmt.Println()
 
+	// Fail fast on an unusable proxy instead of timing out every request
+	if err := configureHTTPProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Align request timestamps with the server clock before any signed request
+	syncRequestClock()
+
+	// Fail fast on a misconfigured symbol override instead of failing every test
+	if err := validateConfiguredSymbol(); err != nil {
+		fmt.Printf("Invalid test configuration: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Using test symbol: %s\n", getTestSymbol())
+
+	// Skip remaining tests once the budget runs out instead of hitting a CI hard kill
+	budget, err := loadTestBudget()
+	if err != nil {
+		fmt.Printf("Invalid test configuration: %v\n", err)
+		os.Exit(1)
+	}
+	runBudget.limit = budget
+	if budget > 0 {
+		fmt.Printf("Using test time budget: %v\n", budget)
+	}
+	fmt.Println()
+
 	// Run tests
 	code := m.Run()
 
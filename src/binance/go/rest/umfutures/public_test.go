@@ -3,935 +3,962 @@ package main
 import (
 	"context"
 	"io"
+	"math"
 	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	openapi "github.com/openxapi/binance-go/rest/umfutures"
+	"github.com/openxapi/integration-tests/src/binance/go/rest/tickerstats"
 )
 
 // TestPing tests the ping endpoint
 func TestPing(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Ping", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetPingV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetPingV1")
-					t.Fatalf("Error calling GetPingV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				t.Logf("Ping successful: %+v", resp)
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Ping", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetPingV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetPingV1")
+			t.Fatalf("Error calling GetPingV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		t.Logf("Ping successful: %+v", resp)
+	})
 }
 
 // TestServerTime tests the server time endpoint
 func TestServerTime(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Server Time", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetTimeV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetTimeV1")
-					t.Fatalf("Error calling GetTimeV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if resp.ServerTime == nil {
-					t.Fatal("ServerTime should not be nil")
-				}
-				
-				serverTime := *resp.ServerTime
-				now := time.Now().UnixMilli()
-				
-				// Check if server time is within 10 seconds of local time
-				if abs(serverTime-now) > 10000 {
-					t.Logf("Warning: Server time (%d) differs significantly from local time (%d)", serverTime, now)
-				}
-				
-				t.Logf("Server time: %d", serverTime)
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Server Time", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetTimeV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetTimeV1")
+			t.Fatalf("Error calling GetTimeV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if resp.ServerTime == nil {
+			t.Fatal("ServerTime should not be nil")
+		}
+		
+		serverTime := *resp.ServerTime
+		now := time.Now().UnixMilli()
+		
+		// Check if server time is within 10 seconds of local time
+		if abs(serverTime-now) > 10000 {
+			t.Logf("Warning: Server time (%d) differs significantly from local time (%d)", serverTime, now)
+		}
+		
+		t.Logf("Server time: %d", serverTime)
+	})
 }
 
 // TestExchangeInfo tests the exchange info endpoint
 func TestExchangeInfo(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Exchange Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetExchangeInfoV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					// Known issue: deliveryDate field type mismatch (int32 vs int64)
-					if strings.Contains(err.Error(), "cannot unmarshal number") && strings.Contains(err.Error(), "deliveryDate") {
-						t.Logf("Known SDK issue detected: deliveryDate field type mismatch (int32 vs int64): %v", err)
-						logResponseBody(t, httpResp, "GetExchangeInfoV1")
-						t.Fatalf("SDK Error - deliveryDate field type mismatch (int32 vs int64): %v", err)
-					}
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetExchangeInfoV1")
-					t.Fatalf("Error calling GetExchangeInfoV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if resp.Symbols == nil || len(resp.Symbols) == 0 {
-					t.Fatal("Symbols should not be empty")
-				}
-				
-				if resp.Assets == nil || len(resp.Assets) == 0 {
-					t.Fatal("Assets should not be empty")
-				}
-				
-				t.Logf("Found %d symbols and %d assets", len(resp.Symbols), len(resp.Assets))
-				
-				// Check first symbol structure
-				if len(resp.Symbols) > 0 {
-					symbol := resp.Symbols[0]
-					if symbol.Symbol == nil || *symbol.Symbol == "" {
-						t.Fatal("Symbol name should not be empty")
-					}
-					if symbol.Status == nil || *symbol.Status == "" {
-						t.Fatal("Symbol status should not be empty")
-					}
-					t.Logf("First symbol: %s, Status: %s", *symbol.Symbol, *symbol.Status)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Exchange Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetExchangeInfoV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			// Known issue: deliveryDate field type mismatch (int32 vs int64)
+			if strings.Contains(err.Error(), "cannot unmarshal number") && strings.Contains(err.Error(), "deliveryDate") {
+				t.Logf("Known SDK issue detected: deliveryDate field type mismatch (int32 vs int64): %v", err)
+				logResponseBody(t, httpResp, "GetExchangeInfoV1")
+				t.Fatalf("SDK Error - deliveryDate field type mismatch (int32 vs int64): %v", err)
+			}
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetExchangeInfoV1")
+			t.Fatalf("Error calling GetExchangeInfoV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if resp.Symbols == nil || len(resp.Symbols) == 0 {
+			t.Fatal("Symbols should not be empty")
+		}
+		
+		if resp.Assets == nil || len(resp.Assets) == 0 {
+			t.Fatal("Assets should not be empty")
+		}
+		
+		t.Logf("Found %d symbols and %d assets", len(resp.Symbols), len(resp.Assets))
+		
+		// Check first symbol structure
+		if len(resp.Symbols) > 0 {
+			symbol := resp.Symbols[0]
+			if symbol.Symbol == nil || *symbol.Symbol == "" {
+				t.Fatal("Symbol name should not be empty")
+			}
+			if symbol.Status == nil || *symbol.Status == "" {
+				t.Fatal("Symbol status should not be empty")
+			}
+			t.Logf("First symbol: %s, Status: %s", *symbol.Symbol, *symbol.Status)
+		}
+	})
+}
+
+// TestDeliveryContracts tests the delivery-specific exchangeInfo fields of quarterly contracts
+func TestDeliveryContracts(t *testing.T) {
+	testEndpoint(t, AuthTypeNONE, "DeliveryContracts", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		resp, httpResp, err := client.FuturesAPI.GetExchangeInfoV1(ctx).Execute()
+		if err != nil {
+			// Delivery timestamps exceed int32, so a narrowed model fails here first
+			if strings.Contains(err.Error(), "cannot unmarshal number") && strings.Contains(err.Error(), "deliveryDate") {
+				logResponseBody(t, httpResp, "GetExchangeInfoV1")
+				t.Fatalf("SDK Error - deliveryDate field type mismatch (int32 vs int64): %v", err)
+			}
+			checkAPIError(t, err)
+			t.Fatalf("Error calling GetExchangeInfoV1: %v", err)
+		}
+		
+		now := time.Now().UnixMilli()
+		// Quarterly contracts are listed at most two quarters ahead
+		maxDelivery := time.Now().AddDate(1, 0, 0).UnixMilli()
+		
+		checked := 0
+		for _, symbol := range resp.Symbols {
+			if symbol.ContractType == nil {
+				continue
+			}
+			contractType := *symbol.ContractType
+			if contractType != "CURRENT_QUARTER" && contractType != "NEXT_QUARTER" {
+				continue
+			}
+			checked++
+			
+			name := ""
+			if symbol.Symbol != nil {
+				name = *symbol.Symbol
+			}
+			if symbol.DeliveryDate == nil || symbol.OnboardDate == nil {
+				t.Errorf("%s (%s) is missing deliveryDate or onboardDate", name, contractType)
+				continue
+			}
+			
+			deliveryDate := int64(*symbol.DeliveryDate)
+			onboardDate := int64(*symbol.OnboardDate)
+			if deliveryDate <= math.MaxInt32 {
+				t.Errorf("%s deliveryDate %d is not a millisecond timestamp", name, deliveryDate)
+			}
+			if onboardDate <= 0 || onboardDate >= deliveryDate {
+				t.Errorf("%s onboardDate %d is not before deliveryDate %d", name, onboardDate, deliveryDate)
+			}
+			if symbol.Status != nil && *symbol.Status == "TRADING" {
+				if deliveryDate <= now {
+					t.Errorf("%s is TRADING but its deliveryDate %s has passed",
+						name, time.UnixMilli(deliveryDate).UTC().Format(time.RFC3339))
+				}
+				if deliveryDate > maxDelivery {
+					t.Errorf("%s deliveryDate %s is more than a year out",
+						name, time.UnixMilli(deliveryDate).UTC().Format(time.RFC3339))
+				}
+			}
+			
+			t.Logf("%s: %s, onboard %s, delivery %s", name, contractType,
+				time.UnixMilli(onboardDate).UTC().Format(time.RFC3339),
+				time.UnixMilli(deliveryDate).UTC().Format(time.RFC3339))
+		}
+		
+		if checked == 0 {
+			t.Skip("No delivery (quarterly) contracts listed on this server")
+		}
+	})
 }
 
 // TestOrderBook tests the order book endpoint
 func TestOrderBook(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Order Book", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetDepthV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					// Known issue: timestamp field type mismatch (int32 vs int64)
-					if strings.Contains(err.Error(), "cannot unmarshal number") && strings.Contains(err.Error(), "int32") {
-						t.Logf("Known SDK issue detected: timestamp field type mismatch (int32 vs int64): %v", err)
-						logResponseBody(t, httpResp, "GetDepthV1")
-						t.Fatalf("SDK Error - timestamp field type mismatch (int32 vs int64): %v", err)
-					}
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetDepthV1")
-					t.Fatalf("Error calling GetDepthV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if resp.Bids == nil || len(resp.Bids) == 0 {
-					t.Fatal("Bids should not be empty")
-				}
-				
-				if resp.Asks == nil || len(resp.Asks) == 0 {
-					t.Fatal("Asks should not be empty")
-				}
-				
-				t.Logf("Order book for BTCUSDT - Bids: %d, Asks: %d", len(resp.Bids), len(resp.Asks))
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Order Book", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetDepthV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			// Known issue: timestamp field type mismatch (int32 vs int64)
+			if strings.Contains(err.Error(), "cannot unmarshal number") && strings.Contains(err.Error(), "int32") {
+				t.Logf("Known SDK issue detected: timestamp field type mismatch (int32 vs int64): %v", err)
+				logResponseBody(t, httpResp, "GetDepthV1")
+				t.Fatalf("SDK Error - timestamp field type mismatch (int32 vs int64): %v", err)
+			}
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetDepthV1")
+			t.Fatalf("Error calling GetDepthV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if resp.Bids == nil || len(resp.Bids) == 0 {
+			t.Fatal("Bids should not be empty")
+		}
+		
+		if resp.Asks == nil || len(resp.Asks) == 0 {
+			t.Fatal("Asks should not be empty")
+		}
+		
+		t.Logf("Order book for %s - Bids: %d, Asks: %d", getTestSymbol(), len(resp.Bids), len(resp.Asks))
+	})
 }
 
 // TestRecentTrades tests the recent trades endpoint
 func TestRecentTrades(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Recent Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetTradesV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetTradesV1")
-					t.Fatalf("Error calling GetTradesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Trades should not be empty")
-				}
-				
-				t.Logf("Found %d recent trades for BTCUSDT", len(resp))
-				
-				// Check first trade structure
-				if len(resp) > 0 {
-					trade := resp[0]
-					if trade.Id == nil {
-						t.Fatal("Trade ID should not be nil")
-					}
-					if trade.Price == nil || *trade.Price == "" {
-						t.Fatal("Trade price should not be empty")
-					}
-					if trade.Qty == nil || *trade.Qty == "" {
-						t.Fatal("Trade quantity should not be empty")
-					}
-					t.Logf("First trade: ID=%d, Price=%s, Qty=%s", *trade.Id, *trade.Price, *trade.Qty)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Recent Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetTradesV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetTradesV1")
+			t.Fatalf("Error calling GetTradesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Trades should not be empty")
+		}
+		
+		t.Logf("Found %d recent trades for %s", len(resp), getTestSymbol())
+		
+		// Check first trade structure
+		if len(resp) > 0 {
+			trade := resp[0]
+			if trade.Id == nil {
+				t.Fatal("Trade ID should not be nil")
+			}
+			if trade.Price == nil || *trade.Price == "" {
+				t.Fatal("Trade price should not be empty")
+			}
+			if trade.Qty == nil || *trade.Qty == "" {
+				t.Fatal("Trade quantity should not be empty")
+			}
+			t.Logf("First trade: ID=%d, Price=%s, Qty=%s", *trade.Id, *trade.Price, *trade.Qty)
+		}
+	})
 }
 
 // TestAggTrades tests the aggregate trades endpoint
 func TestAggTrades(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Aggregate Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetAggTradesV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetAggTradesV1")
-					t.Fatalf("Error calling GetAggTradesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Aggregate trades should not be empty")
-				}
-				
-				t.Logf("Found %d aggregate trades for BTCUSDT", len(resp))
-				
-				// Check first trade structure
-				if len(resp) > 0 {
-					trade := resp[0]
-					if trade.A == nil {
-						t.Fatal("Aggregate trade ID should not be nil")
-					}
-					if trade.P == nil || *trade.P == "" {
-						t.Fatal("Trade price should not be empty")
-					}
-					if trade.Q == nil || *trade.Q == "" {
-						t.Fatal("Trade quantity should not be empty")
-					}
-					t.Logf("First agg trade: ID=%d, Price=%s, Qty=%s", *trade.A, *trade.P, *trade.Q)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Aggregate Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetAggTradesV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetAggTradesV1")
+			t.Fatalf("Error calling GetAggTradesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Aggregate trades should not be empty")
+		}
+		
+		t.Logf("Found %d aggregate trades for %s", len(resp), getTestSymbol())
+		
+		// Check first trade structure
+		if len(resp) > 0 {
+			trade := resp[0]
+			if trade.A == nil {
+				t.Fatal("Aggregate trade ID should not be nil")
+			}
+			if trade.P == nil || *trade.P == "" {
+				t.Fatal("Trade price should not be empty")
+			}
+			if trade.Q == nil || *trade.Q == "" {
+				t.Fatal("Trade quantity should not be empty")
+			}
+			t.Logf("First agg trade: ID=%d, Price=%s, Qty=%s", *trade.A, *trade.P, *trade.Q)
+		}
+	})
 }
 
 // TestKlines tests the klines endpoint
 func TestKlines(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetKlinesV1(ctx).Symbol("BTCUSDT").Interval("1m")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetKlinesV1")
-					t.Fatalf("Error calling GetKlinesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Klines should not be empty")
-				}
-				
-				t.Logf("Found %d klines for BTCUSDT 1m", len(resp))
-				
-				// Check first kline structure
-				if len(resp) > 0 {
-					kline := resp[0]
-					if len(kline) < 6 {
-						t.Fatal("Kline should have at least 6 elements")
-					}
-					t.Logf("First kline: %+v", kline)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetKlinesV1(ctx).Symbol(getTestSymbol()).Interval("1m")
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetKlinesV1")
+			t.Fatalf("Error calling GetKlinesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Klines should not be empty")
+		}
+		
+		t.Logf("Found %d klines for %s 1m", len(resp), getTestSymbol())
+		
+		// Check first kline structure
+		if len(resp) > 0 {
+			kline := resp[0]
+			if len(kline) < 6 {
+				t.Fatal("Kline should have at least 6 elements")
+			}
+			t.Logf("First kline: %+v", kline)
+		}
+	})
 }
 
 // Test24hrTicker tests the 24hr ticker endpoint
 func Test24hrTicker(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "24hr Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetTicker24hrV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetTicker24hrV1")
-					t.Fatalf("Error calling GetTicker24hrV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				// Handle both single and array response
-				if resp.UmfuturesGetTicker24hrV1RespItem != nil {
-					// Single item response
-					ticker := resp.UmfuturesGetTicker24hrV1RespItem
-					if ticker.Symbol == nil || *ticker.Symbol == "" {
-						t.Fatal("Ticker symbol should not be empty")
-					}
-					if ticker.LastPrice == nil || *ticker.LastPrice == "" {
-						t.Fatal("Last price should not be empty")
-					}
-					t.Logf("24hr ticker: %s, LastPrice=%s", *ticker.Symbol, *ticker.LastPrice)
-				} else if resp.ArrayOfUmfuturesGetTicker24hrV1RespItem != nil {
-					// Array response
-					tickers := *resp.ArrayOfUmfuturesGetTicker24hrV1RespItem
-					if len(tickers) == 0 {
-						t.Fatal("24hr ticker array should not be empty")
-					}
-					t.Logf("Found %d 24hr tickers", len(tickers))
-					
-					// Check first ticker structure
-					if len(tickers) > 0 {
-						ticker := tickers[0]
-						if ticker.Symbol == nil || *ticker.Symbol == "" {
-							t.Fatal("Ticker symbol should not be empty")
-						}
-						if ticker.LastPrice == nil || *ticker.LastPrice == "" {
-							t.Fatal("Last price should not be empty")
-						}
-						t.Logf("First ticker: %s, LastPrice=%s", *ticker.Symbol, *ticker.LastPrice)
-					}
-				} else {
-					t.Fatal("No valid response received")
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "24hr Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetTicker24hrV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetTicker24hrV1")
+			t.Fatalf("Error calling GetTicker24hrV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		// Handle both single and array response
+		if resp.UmfuturesGetTicker24hrV1RespItem != nil {
+			// Single item response
+			ticker := resp.UmfuturesGetTicker24hrV1RespItem
+			if ticker.Symbol == nil || *ticker.Symbol == "" {
+				t.Fatal("Ticker symbol should not be empty")
+			}
+			if ticker.LastPrice == nil || *ticker.LastPrice == "" {
+				t.Fatal("Last price should not be empty")
+			}
+			for _, problem := range tickerstats.Violations(ticker.OpenPrice, ticker.LastPrice, ticker.HighPrice, ticker.LowPrice, ticker.WeightedAvgPrice, ticker.PriceChangePercent) {
+				t.Errorf("%s 24hr ticker: %s", *ticker.Symbol, problem)
+			}
+			t.Logf("24hr ticker: %s, LastPrice=%s", *ticker.Symbol, *ticker.LastPrice)
+		} else if resp.ArrayOfUmfuturesGetTicker24hrV1RespItem != nil {
+			// Array response
+			tickers := *resp.ArrayOfUmfuturesGetTicker24hrV1RespItem
+			if len(tickers) == 0 {
+				t.Fatal("24hr ticker array should not be empty")
+			}
+			t.Logf("Found %d 24hr tickers", len(tickers))
+			
+			// Check first ticker structure
+			if len(tickers) > 0 {
+				ticker := tickers[0]
+				if ticker.Symbol == nil || *ticker.Symbol == "" {
+					t.Fatal("Ticker symbol should not be empty")
+				}
+				if ticker.LastPrice == nil || *ticker.LastPrice == "" {
+					t.Fatal("Last price should not be empty")
+				}
+				t.Logf("First ticker: %s, LastPrice=%s", *ticker.Symbol, *ticker.LastPrice)
+			}
+			
+			// Check the derived statistics of every contract that traded in the window;
+			// idle contracts report placeholder prices
+			inconsistent, idle := 0, 0
+			for _, ticker := range tickers {
+				if ticker.Count != nil && *ticker.Count == 0 {
+					idle++
+					continue
+				}
+				problems := tickerstats.Violations(ticker.OpenPrice, ticker.LastPrice, ticker.HighPrice, ticker.LowPrice, ticker.WeightedAvgPrice, ticker.PriceChangePercent)
+				if len(problems) == 0 {
+					continue
+				}
+				inconsistent++
+				symbol := "<unknown>"
+				if ticker.Symbol != nil {
+					symbol = *ticker.Symbol
+				}
+				for _, problem := range problems {
+					t.Errorf("%s 24hr ticker: %s", symbol, problem)
+				}
+			}
+			t.Logf("%d/%d traded tickers have internally consistent statistics (%d idle skipped)", len(tickers)-idle-inconsistent, len(tickers)-idle, idle)
+		} else {
+			t.Fatal("No valid response received")
+		}
+	})
 }
 
 // TestPriceTicker tests the price ticker endpoint
 func TestPriceTicker(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Price Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetTickerPriceV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetTickerPriceV1")
-					t.Fatalf("Error calling GetTickerPriceV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+	testEndpoint(t, AuthTypeNONE, "Price Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetTickerPriceV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetTickerPriceV1")
+			t.Fatalf("Error calling GetTickerPriceV1: %v", err)
+		}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		// Handle both single and array response
+		if resp.UmfuturesGetTickerPriceV1RespItem != nil {
+			// Single item response
+			item := resp.UmfuturesGetTickerPriceV1RespItem
+			if item.Symbol == nil || *item.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			if item.Price == nil || *item.Price == "" {
+				t.Fatal("Price should not be empty")
+			}
+			t.Logf("Price ticker: %s = %s", *item.Symbol, *item.Price)
+		} else if resp.ArrayOfUmfuturesGetTickerPriceV1RespItem != nil {
+			// Array response
+			items := *resp.ArrayOfUmfuturesGetTickerPriceV1RespItem
+			if len(items) == 0 {
+				t.Fatal("Price ticker array should not be empty")
+			}
+			t.Logf("Found %d price tickers", len(items))
+			
+			// Check first item
+			if len(items) > 0 {
+				item := items[0]
+				if item.Symbol == nil || *item.Symbol == "" {
+					t.Fatal("Symbol should not be empty")
 				}
-				
-				// Handle both single and array response
-				if resp.UmfuturesGetTickerPriceV1RespItem != nil {
-					// Single item response
-					item := resp.UmfuturesGetTickerPriceV1RespItem
-					if item.Symbol == nil || *item.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					if item.Price == nil || *item.Price == "" {
-						t.Fatal("Price should not be empty")
-					}
-					t.Logf("Price ticker: %s = %s", *item.Symbol, *item.Price)
-				} else if resp.ArrayOfUmfuturesGetTickerPriceV1RespItem != nil {
-					// Array response
-					items := *resp.ArrayOfUmfuturesGetTickerPriceV1RespItem
-					if len(items) == 0 {
-						t.Fatal("Price ticker array should not be empty")
-					}
-					t.Logf("Found %d price tickers", len(items))
-					
-					// Check first item
-					if len(items) > 0 {
-						item := items[0]
-						if item.Symbol == nil || *item.Symbol == "" {
-							t.Fatal("Symbol should not be empty")
-						}
-						if item.Price == nil || *item.Price == "" {
-							t.Fatal("Price should not be empty")
-						}
-						t.Logf("First price ticker: %s = %s", *item.Symbol, *item.Price)
-					}
-				} else {
-					t.Fatal("No valid response received")
+				if item.Price == nil || *item.Price == "" {
+					t.Fatal("Price should not be empty")
 				}
-			})
-			break
+				t.Logf("First price ticker: %s = %s", *item.Symbol, *item.Price)
+			}
+		} else {
+			t.Fatal("No valid response received")
 		}
-	}
+	})
 }
 
 // TestBookTicker tests the book ticker endpoint
 func TestBookTicker(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Book Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetTickerBookTickerV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetTickerBookTickerV1")
-					t.Fatalf("Error calling GetTickerBookTickerV1: %v", err)
+	testEndpoint(t, AuthTypeNONE, "Book Ticker", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetTickerBookTickerV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetTickerBookTickerV1")
+			t.Fatalf("Error calling GetTickerBookTickerV1: %v", err)
+		}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		// Handle both single and array response
+		if resp.UmfuturesGetTickerBookTickerV1RespItem != nil {
+			// Single item response
+			item := resp.UmfuturesGetTickerBookTickerV1RespItem
+			if item.Symbol == nil || *item.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			if item.BidPrice == nil || *item.BidPrice == "" {
+				t.Fatal("Bid price should not be empty")
+			}
+			if item.AskPrice == nil || *item.AskPrice == "" {
+				t.Fatal("Ask price should not be empty")
+			}
+			t.Logf("Book ticker: %s, Bid=%s, Ask=%s", *item.Symbol, *item.BidPrice, *item.AskPrice)
+		} else if resp.ArrayOfUmfuturesGetTickerBookTickerV1RespItem != nil {
+			// Array response
+			items := *resp.ArrayOfUmfuturesGetTickerBookTickerV1RespItem
+			if len(items) == 0 {
+				t.Fatal("Book ticker array should not be empty")
+			}
+			t.Logf("Found %d book tickers", len(items))
+			
+			// Check first item
+			if len(items) > 0 {
+				item := items[0]
+				if item.Symbol == nil || *item.Symbol == "" {
+					t.Fatal("Symbol should not be empty")
 				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+				if item.BidPrice == nil || *item.BidPrice == "" {
+					t.Fatal("Bid price should not be empty")
 				}
-				
-				// Handle both single and array response
-				if resp.UmfuturesGetTickerBookTickerV1RespItem != nil {
-					// Single item response
-					item := resp.UmfuturesGetTickerBookTickerV1RespItem
-					if item.Symbol == nil || *item.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					if item.BidPrice == nil || *item.BidPrice == "" {
-						t.Fatal("Bid price should not be empty")
-					}
-					if item.AskPrice == nil || *item.AskPrice == "" {
-						t.Fatal("Ask price should not be empty")
-					}
-					t.Logf("Book ticker: %s, Bid=%s, Ask=%s", *item.Symbol, *item.BidPrice, *item.AskPrice)
-				} else if resp.ArrayOfUmfuturesGetTickerBookTickerV1RespItem != nil {
-					// Array response
-					items := *resp.ArrayOfUmfuturesGetTickerBookTickerV1RespItem
-					if len(items) == 0 {
-						t.Fatal("Book ticker array should not be empty")
-					}
-					t.Logf("Found %d book tickers", len(items))
-					
-					// Check first item
-					if len(items) > 0 {
-						item := items[0]
-						if item.Symbol == nil || *item.Symbol == "" {
-							t.Fatal("Symbol should not be empty")
-						}
-						if item.BidPrice == nil || *item.BidPrice == "" {
-							t.Fatal("Bid price should not be empty")
-						}
-						if item.AskPrice == nil || *item.AskPrice == "" {
-							t.Fatal("Ask price should not be empty")
-						}
-						t.Logf("First book ticker: %s, Bid=%s, Ask=%s", *item.Symbol, *item.BidPrice, *item.AskPrice)
-					}
-				} else {
-					t.Fatal("No valid response received")
+				if item.AskPrice == nil || *item.AskPrice == "" {
+					t.Fatal("Ask price should not be empty")
 				}
-			})
-			break
+				t.Logf("First book ticker: %s, Bid=%s, Ask=%s", *item.Symbol, *item.BidPrice, *item.AskPrice)
+			}
+		} else {
+			t.Fatal("No valid response received")
 		}
-	}
+	})
 }
 
 // TestOpenInterest tests the open interest endpoint
 func TestOpenInterest(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Open Interest", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetOpenInterestV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetOpenInterestV1")
-					t.Fatalf("Error calling GetOpenInterestV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if resp.OpenInterest == nil || *resp.OpenInterest == "" {
-					t.Fatal("Open interest should not be empty")
-				}
-				
-				t.Logf("Open interest for BTCUSDT: %s", *resp.OpenInterest)
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Open Interest", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetOpenInterestV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetOpenInterestV1")
+			t.Fatalf("Error calling GetOpenInterestV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if resp.OpenInterest == nil || *resp.OpenInterest == "" {
+			t.Fatal("Open interest should not be empty")
+		}
+		
+		t.Logf("Open interest for %s: %s", getTestSymbol(), *resp.OpenInterest)
+	})
 }
 
 // TestPremiumIndex tests the premium index endpoint
 func TestPremiumIndex(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Premium Index", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetPremiumIndexV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetPremiumIndexV1")
-					t.Fatalf("Error calling GetPremiumIndexV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+	testEndpoint(t, AuthTypeNONE, "Premium Index", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetPremiumIndexV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetPremiumIndexV1")
+			t.Fatalf("Error calling GetPremiumIndexV1: %v", err)
+		}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		// Handle both single and array response
+		if resp.UmfuturesGetPremiumIndexV1RespItem != nil {
+			// Single item response
+			item := resp.UmfuturesGetPremiumIndexV1RespItem
+			if item.Symbol == nil || *item.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			if item.MarkPrice == nil || *item.MarkPrice == "" {
+				t.Fatal("Mark price should not be empty")
+			}
+			t.Logf("Premium index: %s, MarkPrice=%s", *item.Symbol, *item.MarkPrice)
+		} else if resp.ArrayOfUmfuturesGetPremiumIndexV1RespItem != nil {
+			// Array response
+			items := *resp.ArrayOfUmfuturesGetPremiumIndexV1RespItem
+			if len(items) == 0 {
+				t.Fatal("Premium index array should not be empty")
+			}
+			t.Logf("Found %d premium index entries", len(items))
+			
+			// Check first entry structure
+			if len(items) > 0 {
+				entry := items[0]
+				if entry.Symbol == nil || *entry.Symbol == "" {
+					t.Fatal("Symbol should not be empty")
 				}
-				
-				// Handle both single and array response
-				if resp.UmfuturesGetPremiumIndexV1RespItem != nil {
-					// Single item response
-					item := resp.UmfuturesGetPremiumIndexV1RespItem
-					if item.Symbol == nil || *item.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					if item.MarkPrice == nil || *item.MarkPrice == "" {
-						t.Fatal("Mark price should not be empty")
-					}
-					t.Logf("Premium index: %s, MarkPrice=%s", *item.Symbol, *item.MarkPrice)
-				} else if resp.ArrayOfUmfuturesGetPremiumIndexV1RespItem != nil {
-					// Array response
-					items := *resp.ArrayOfUmfuturesGetPremiumIndexV1RespItem
-					if len(items) == 0 {
-						t.Fatal("Premium index array should not be empty")
-					}
-					t.Logf("Found %d premium index entries", len(items))
-					
-					// Check first entry structure
-					if len(items) > 0 {
-						entry := items[0]
-						if entry.Symbol == nil || *entry.Symbol == "" {
-							t.Fatal("Symbol should not be empty")
-						}
-						if entry.MarkPrice == nil || *entry.MarkPrice == "" {
-							t.Fatal("Mark price should not be empty")
-						}
-						t.Logf("First premium index: %s, MarkPrice=%s", *entry.Symbol, *entry.MarkPrice)
-					}
-				} else {
-					t.Fatal("No valid response received")
+				if entry.MarkPrice == nil || *entry.MarkPrice == "" {
+					t.Fatal("Mark price should not be empty")
 				}
-			})
-			break
+				t.Logf("First premium index: %s, MarkPrice=%s", *entry.Symbol, *entry.MarkPrice)
+			}
+		} else {
+			t.Fatal("No valid response received")
 		}
-	}
+	})
 }
 
 // TestFundingRate tests the funding rate endpoint
 func TestFundingRate(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Funding Rate", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetFundingRateV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetFundingRateV1")
-					t.Fatalf("Error calling GetFundingRateV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Funding rate should not be empty")
-				}
-				
-				t.Logf("Found %d funding rate entries for BTCUSDT", len(resp))
-				
-				// Check first entry structure
-				if len(resp) > 0 {
-					entry := resp[0]
-					if entry.Symbol == nil || *entry.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					if entry.FundingRate == nil || *entry.FundingRate == "" {
-						t.Fatal("Funding rate should not be empty")
-					}
-					t.Logf("First funding rate: %s, Rate=%s", *entry.Symbol, *entry.FundingRate)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Funding Rate", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetFundingRateV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetFundingRateV1")
+			t.Fatalf("Error calling GetFundingRateV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Funding rate should not be empty")
+		}
+		
+		t.Logf("Found %d funding rate entries for %s", len(resp), getTestSymbol())
+		
+		// Check first entry structure
+		if len(resp) > 0 {
+			entry := resp[0]
+			if entry.Symbol == nil || *entry.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			if entry.FundingRate == nil || *entry.FundingRate == "" {
+				t.Fatal("Funding rate should not be empty")
+			}
+			t.Logf("First funding rate: %s, Rate=%s", *entry.Symbol, *entry.FundingRate)
+		}
+	})
 }
 
 // TestFundingInfo tests the funding info endpoint
 func TestFundingInfo(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Funding Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetFundingInfoV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					// Check if this is the known issue that the endpoint doesn't exist on Binance 
-					if httpResp != nil && httpResp.StatusCode == 404 {
-						logResponseBody(t, httpResp, "GetFundingInfoV1")
-						t.Skip("GetFundingInfoV1 endpoint not supported by Binance API (404 Not Found)")
-						return
-					}
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetFundingInfoV1")
-					t.Fatalf("Error calling GetFundingInfoV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Funding info should not be empty")
-				}
-				
-				t.Logf("Found %d funding info entries", len(resp))
-				
-				// Check first entry structure
-				if len(resp) > 0 {
-					entry := resp[0]
-					if entry.Symbol == nil || *entry.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					t.Logf("First funding info: %s", *entry.Symbol)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Funding Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetFundingInfoV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			// Check if this is the known issue that the endpoint doesn't exist on Binance 
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				logResponseBody(t, httpResp, "GetFundingInfoV1")
+				t.Skip("GetFundingInfoV1 endpoint not supported by Binance API (404 Not Found)")
+				return
+			}
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetFundingInfoV1")
+			t.Fatalf("Error calling GetFundingInfoV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Funding info should not be empty")
+		}
+		
+		t.Logf("Found %d funding info entries", len(resp))
+		
+		// Check first entry structure
+		if len(resp) > 0 {
+			entry := resp[0]
+			if entry.Symbol == nil || *entry.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			t.Logf("First funding info: %s", *entry.Symbol)
+		}
+	})
 }
 
 // TestIndexInfo tests the index info endpoint
 func TestIndexInfo(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Index Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetIndexInfoV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetIndexInfoV1")
-					t.Fatalf("Error calling GetIndexInfoV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Index info should not be empty")
-				}
-				
-				t.Logf("Found %d index info entries", len(resp))
-				
-				// Check first entry structure
-				if len(resp) > 0 {
-					entry := resp[0]
-					if entry.Symbol == nil || *entry.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					t.Logf("First index info: %s", *entry.Symbol)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Index Info", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetIndexInfoV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetIndexInfoV1")
+			t.Fatalf("Error calling GetIndexInfoV1: %v", err)
+		}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Index info should not be empty")
 		}
+		
+		t.Logf("Found %d index info entries", len(resp))
+		
+		// Check first entry structure
+		if len(resp) > 0 {
+			entry := resp[0]
+			if entry.Symbol == nil || *entry.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			t.Logf("First index info: %s", *entry.Symbol)
+		}
+	})
+}
+
+const (
+	// mainnetEnvVar opts in to the public tests that only work against production
+	mainnetEnvVar = "BINANCE_TEST_UMFUTURES_MAINNET"
+	// umfuturesMainnetURL is the USD-M futures production base URL
+	umfuturesMainnetURL = "https://fapi.binance.com"
+	// constituentWeightTolerance bounds how far index weights may sum from 1.0 after rounding
+	constituentWeightTolerance = 0.01
+)
+
+// mainnetPublicEndpoint runs testFunc against production with an unauthenticated client,
+// skipping unless BINANCE_TEST_UMFUTURES_MAINNET is "true"
+func mainnetPublicEndpoint(t *testing.T, testName string, testFunc func(*testing.T, *openapi.APIClient, context.Context)) {
+	if os.Getenv(mainnetEnvVar) != "true" {
+		t.Skipf("%s is not served by testnet. Set %s=true to run it against production", testName, mainnetEnvVar)
 	}
+
+	rateLimiter.WaitForRateLimit()
+	client, ctx := setupClient(TestConfig{Name: "Mainnet Public", AuthType: AuthTypeNONE})
+	client.GetConfig().Servers = openapi.ServerConfigurations{
+		{
+			URL:         umfuturesMainnetURL,
+			Description: "Binance USD-M Futures Production",
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	testFunc(t, client, timeoutCtx)
 }
 
-// TestConstituents tests the constituents endpoint
-func TestConstituents(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Constituents", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetConstituentsV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetConstituentsV1")
-					t.Fatalf("Error calling GetConstituentsV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if resp.Symbol == nil || *resp.Symbol == "" {
-					t.Fatal("Symbol should not be empty")
-				}
-				
-				t.Logf("Constituents for %s: %+v", *resp.Symbol, resp)
-			})
-			break
+// TestIndexConstituents tests that the index price constituents name their exchange, carry a
+// parseable weight and that the weights sum to 1. Testnet does not serve the endpoint.
+func TestIndexConstituents(t *testing.T) {
+	mainnetPublicEndpoint(t, "IndexConstituents", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		symbol := getTestSymbol()
+		resp, httpResp, err := client.FuturesAPI.GetConstituentsV1(ctx).Symbol(symbol).Execute()
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetConstituentsV1")
+			t.Fatalf("Error calling GetConstituentsV1: %v", err)
 		}
-	}
+		
+		if resp.Symbol == nil || *resp.Symbol != symbol {
+			t.Errorf("Expected symbol %s, got %v", symbol, resp.Symbol)
+		}
+		if len(resp.Constituents) == 0 {
+			t.Fatalf("Expected constituents for %s", symbol)
+		}
+		
+		var total float64
+		t.Logf("Index constituents for %s:", symbol)
+		for i, constituent := range resp.Constituents {
+			if constituent.Exchange == nil || *constituent.Exchange == "" {
+				t.Errorf("Constituent %d has no exchange", i)
+			}
+			if constituent.Weight == nil {
+				t.Errorf("Constituent %d has no weight", i)
+				continue
+			}
+			weight, err := strconv.ParseFloat(*constituent.Weight, 64)
+			if err != nil || weight <= 0 || weight > 1 {
+				t.Errorf("Constituent %d weight %q is not a float in (0, 1]: %v", i, *constituent.Weight, err)
+				continue
+			}
+			total += weight
+			
+			var exchange, pair string
+			if constituent.Exchange != nil {
+				exchange = *constituent.Exchange
+			}
+			if constituent.Symbol != nil {
+				pair = *constituent.Symbol
+			}
+			t.Logf("  %-12s %-12s weight=%.4f", exchange, pair, weight)
+		}
+		
+		if math.Abs(total-1) > constituentWeightTolerance {
+			t.Errorf("Constituent weights sum to %.4f, expected ~1.0", total)
+		}
+		t.Logf("%d constituents, weights sum to %.4f", len(resp.Constituents), total)
+	})
 }
 
 // TestAssetIndex tests the asset index endpoint
 func TestAssetIndex(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Asset Index", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetAssetIndexV1(ctx)
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetAssetIndexV1")
-					t.Fatalf("Error calling GetAssetIndexV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				// Handle both single and array response
-				if resp.UmfuturesGetAssetIndexV1RespItem != nil {
-					// Single item response
-					item := resp.UmfuturesGetAssetIndexV1RespItem
-					if item.Symbol == nil || *item.Symbol == "" {
-						t.Fatal("Symbol should not be empty")
-					}
-					t.Logf("Asset index: %s", *item.Symbol)
-				} else if resp.ArrayOfUmfuturesGetAssetIndexV1RespItem != nil {
-					// Array response
-					items := *resp.ArrayOfUmfuturesGetAssetIndexV1RespItem
-					if len(items) == 0 {
-						t.Fatal("Asset index array should not be empty")
-					}
-					t.Logf("Found %d asset index entries", len(items))
-					
-					// Check first entry structure
-					if len(items) > 0 {
-						entry := items[0]
-						if entry.Symbol == nil || *entry.Symbol == "" {
-							t.Fatal("Symbol should not be empty")
-						}
-						t.Logf("First asset index: %s", *entry.Symbol)
-					}
-				} else {
-					t.Fatal("No valid response received")
+	testEndpoint(t, AuthTypeNONE, "Asset Index", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetAssetIndexV1(ctx)
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetAssetIndexV1")
+			t.Fatalf("Error calling GetAssetIndexV1: %v", err)
+		}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		// Handle both single and array response
+		if resp.UmfuturesGetAssetIndexV1RespItem != nil {
+			// Single item response
+			item := resp.UmfuturesGetAssetIndexV1RespItem
+			if item.Symbol == nil || *item.Symbol == "" {
+				t.Fatal("Symbol should not be empty")
+			}
+			t.Logf("Asset index: %s", *item.Symbol)
+		} else if resp.ArrayOfUmfuturesGetAssetIndexV1RespItem != nil {
+			// Array response
+			items := *resp.ArrayOfUmfuturesGetAssetIndexV1RespItem
+			if len(items) == 0 {
+				t.Fatal("Asset index array should not be empty")
+			}
+			t.Logf("Found %d asset index entries", len(items))
+			
+			// Check first entry structure
+			if len(items) > 0 {
+				entry := items[0]
+				if entry.Symbol == nil || *entry.Symbol == "" {
+					t.Fatal("Symbol should not be empty")
 				}
-			})
-			break
+				t.Logf("First asset index: %s", *entry.Symbol)
+			}
+		} else {
+			t.Fatal("No valid response received")
 		}
-	}
+	})
 }
 
 // TestContinuousKlines tests the continuous klines endpoint
 func TestContinuousKlines(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Continuous Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetContinuousKlinesV1(ctx).Pair("BTCUSDT").ContractType("PERPETUAL").Interval("1m")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetContinuousKlinesV1")
-					t.Fatalf("Error calling GetContinuousKlinesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Continuous klines should not be empty")
-				}
-				
-				t.Logf("Found %d continuous klines for BTCUSDT perpetual", len(resp))
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Continuous Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetContinuousKlinesV1(ctx).Pair("BTCUSDT").ContractType("PERPETUAL").Interval("1m")
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetContinuousKlinesV1")
+			t.Fatalf("Error calling GetContinuousKlinesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Continuous klines should not be empty")
+		}
+		
+		t.Logf("Found %d continuous klines for BTCUSDT perpetual", len(resp))
+	})
 }
 
 // TestIndexPriceKlines tests the index price klines endpoint
 func TestIndexPriceKlines(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Index Price Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetIndexPriceKlinesV1(ctx).Pair("BTCUSDT").Interval("1m")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetIndexPriceKlinesV1")
-					t.Fatalf("Error calling GetIndexPriceKlinesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Index price klines should not be empty")
-				}
-				
-				t.Logf("Found %d index price klines for BTCUSDT", len(resp))
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Index Price Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetIndexPriceKlinesV1(ctx).Pair("BTCUSDT").Interval("1m")
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetIndexPriceKlinesV1")
+			t.Fatalf("Error calling GetIndexPriceKlinesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Index price klines should not be empty")
+		}
+		
+		t.Logf("Found %d index price klines for BTCUSDT", len(resp))
+	})
 }
 
 // TestMarkPriceKlines tests the mark price klines endpoint
 func TestMarkPriceKlines(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Mark Price Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetMarkPriceKlinesV1(ctx).Symbol("BTCUSDT").Interval("1m")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetMarkPriceKlinesV1")
-					t.Fatalf("Error calling GetMarkPriceKlinesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Mark price klines should not be empty")
-				}
-				
-				t.Logf("Found %d mark price klines for BTCUSDT", len(resp))
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Mark Price Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetMarkPriceKlinesV1(ctx).Symbol(getTestSymbol()).Interval("1m")
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetMarkPriceKlinesV1")
+			t.Fatalf("Error calling GetMarkPriceKlinesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Mark price klines should not be empty")
+		}
+		
+		t.Logf("Found %d mark price klines for %s", len(resp), getTestSymbol())
+	})
 }
 
 // TestPremiumIndexKlines tests the premium index klines endpoint
 func TestPremiumIndexKlines(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType == AuthTypeNONE {
-			testEndpoint(t, config, "Premium Index Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetPremiumIndexKlinesV1(ctx).Symbol("BTCUSDT").Interval("1m")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetPremiumIndexKlinesV1")
-					t.Fatalf("Error calling GetPremiumIndexKlinesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Premium index klines should not be empty")
-				}
-				
-				t.Logf("Found %d premium index klines for BTCUSDT", len(resp))
-			})
-			break
+	testEndpoint(t, AuthTypeNONE, "Premium Index Klines", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetPremiumIndexKlinesV1(ctx).Symbol(getTestSymbol()).Interval("1m")
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetPremiumIndexKlinesV1")
+			t.Fatalf("Error calling GetPremiumIndexKlinesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Premium index klines should not be empty")
+		}
+		
+		t.Logf("Found %d premium index klines for %s", len(resp), getTestSymbol())
+	})
 }
 
 // TestHistoricalTrades tests the historical trades endpoint
 func TestHistoricalTrades(t *testing.T) {
-	configs := getTestConfigs()
-	for _, config := range configs {
-		if config.AuthType >= AuthTypeUSER_DATA {
-			testEndpoint(t, config, "Historical Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
-				req := client.FuturesAPI.GetHistoricalTradesV1(ctx).Symbol("BTCUSDT")
-				resp, httpResp, err := req.Execute()
-				
-				if err != nil {
-					checkAPIError(t, err)
-					logResponseBody(t, httpResp, "GetHistoricalTradesV1")
-					t.Fatalf("Error calling GetHistoricalTradesV1: %v", err)
-				}
-				
-				if httpResp.StatusCode != 200 {
-					t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
-				}
-				
-				if len(resp) == 0 {
-					t.Fatal("Historical trades should not be empty")
-				}
-				
-				t.Logf("Found %d historical trades for BTCUSDT", len(resp))
-				
-				// Check first trade structure
-				if len(resp) > 0 {
-					trade := resp[0]
-					if trade.Id == nil {
-						t.Fatal("Trade ID should not be nil")
-					}
-					if trade.Price == nil || *trade.Price == "" {
-						t.Fatal("Trade price should not be empty")
-					}
-					t.Logf("First historical trade: ID=%d, Price=%s", *trade.Id, *trade.Price)
-				}
-			})
-			break
+	testEndpoint(t, AuthTypeUSER_DATA, "Historical Trades", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+		req := client.FuturesAPI.GetHistoricalTradesV1(ctx).Symbol(getTestSymbol())
+		resp, httpResp, err := req.Execute()
+		
+		if err != nil {
+			checkAPIError(t, err)
+			logResponseBody(t, httpResp, "GetHistoricalTradesV1")
+			t.Fatalf("Error calling GetHistoricalTradesV1: %v", err)
 		}
-	}
+		
+		if httpResp.StatusCode != 200 {
+			t.Fatalf("Expected status 200, got %d", httpResp.StatusCode)
+		}
+		
+		if len(resp) == 0 {
+			t.Fatal("Historical trades should not be empty")
+		}
+		
+		t.Logf("Found %d historical trades for %s", len(resp), getTestSymbol())
+		
+		// Check first trade structure
+		if len(resp) > 0 {
+			trade := resp[0]
+			if trade.Id == nil {
+				t.Fatal("Trade ID should not be nil")
+			}
+			if trade.Price == nil || *trade.Price == "" {
+				t.Fatal("Trade price should not be empty")
+			}
+			t.Logf("First historical trade: ID=%d, Price=%s", *trade.Id, *trade.Price)
+		}
+	})
 }
 
 // logResponseBody logs the HTTP response body for debugging failed API calls
@@ -970,4 +997,199 @@ func abs(x int64) int64 {
 		return -x
 	}
 	return x
-}
\ No newline at end of file
+}
+
+// TestSymbolValidation verifies that a rejected BINANCE_TEST_UMFUTURES_SYMBOL names the env var
+func TestSymbolValidation(t *testing.T) {
+	statuses := map[string]string{
+		"BTCUSDT":        "TRADING",
+		"ETHUSDT_250328": "SETTLING",
+	}
+
+	if err := validateSymbolStatus("BINANCE_TEST_UMFUTURES_SYMBOL", "BTCUSDT", statuses); err != nil {
+		t.Fatalf("Expected BTCUSDT to be valid, got: %v", err)
+	}
+	for _, symbol := range []string{"ETHUSDT_250328", "DOESNOTEXIST"} {
+		err := validateSymbolStatus("BINANCE_TEST_UMFUTURES_SYMBOL", symbol, statuses)
+		if err == nil || !strings.Contains(err.Error(), `BINANCE_TEST_UMFUTURES_SYMBOL="`+symbol+`"`) {
+			t.Errorf("Expected %s to be rejected naming BINANCE_TEST_UMFUTURES_SYMBOL, got: %v", symbol, err)
+		}
+	}
+}
+
+// TestNonTradingReason verifies which market states make requireTrading skip
+func TestNonTradingReason(t *testing.T) {
+	statuses := map[string]string{
+		"BTCUSDT":        "TRADING",
+		"ETHUSDT_250328": "SETTLING",
+	}
+
+	tests := []struct {
+		symbol   string
+		wantSkip string
+	}{
+		{"BTCUSDT", ""},
+		{"ETHUSDT_250328", "market status SETTLING"},
+		{"DOESNOTEXIST", "not listed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			reason := nonTradingReason(tt.symbol, statuses)
+			if tt.wantSkip == "" {
+				if reason != "" {
+					t.Fatalf("Expected %s to be tradable, got skip reason: %s", tt.symbol, reason)
+				}
+				return
+			}
+			if !strings.Contains(reason, tt.wantSkip) || !strings.Contains(reason, tt.symbol) {
+				t.Fatalf("Skip reason should mention %q and the symbol, got: %q", tt.wantSkip, reason)
+			}
+		})
+	}
+}
+
+// TestBudgetExhausted tests the time budget decision used to skip remaining tests
+func TestBudgetExhausted(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		limit   time.Duration
+		elapsed time.Duration
+		want    bool
+	}{
+		{"Unlimited", 0, 24 * time.Hour, false},
+		{"WithinBudget", 10 * time.Minute, 9*time.Minute + 59*time.Second, false},
+		{"AtBudget", 10 * time.Minute, 10 * time.Minute, true},
+		{"OverBudget", 10 * time.Minute, 15 * time.Minute, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := testBudget{start: start, limit: tt.limit}
+			if got := b.exhausted(start.Add(tt.elapsed)); got != tt.want {
+				t.Errorf("exhausted() after %v with limit %v = %v, want %v", tt.elapsed, tt.limit, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		t.Setenv(budgetEnvVar, "")
+		if limit, err := loadTestBudget(); err != nil || limit != 0 {
+			t.Errorf("Expected unset budget to be unlimited, got %v, %v", limit, err)
+		}
+		t.Setenv(budgetEnvVar, "90s")
+		if limit, err := loadTestBudget(); err != nil || limit != 90*time.Second {
+			t.Errorf("Expected 90s budget, got %v, %v", limit, err)
+		}
+		for _, invalid := range []string{"ten minutes", "0s", "-5m"} {
+			t.Setenv(budgetEnvVar, invalid)
+			if _, err := loadTestBudget(); err == nil {
+				t.Errorf("Expected error for %s=%q", budgetEnvVar, invalid)
+			}
+		}
+	})
+}
+
+// TestEndpointConfigSelection tests that testEndpoint runs only the first config providing the
+// required auth unless all-auth mode is on, and public tests only the public config
+func TestEndpointConfigSelection(t *testing.T) {
+	original := rateLimiter
+	rateLimiter = &RateLimitManager{}
+	t.Cleanup(func() { rateLimiter = original })
+	
+	t.Setenv("TEST_ALL_AUTH_TYPES", "")
+	t.Setenv("BINANCE_API_KEY", "hmac-key")
+	t.Setenv("BINANCE_SECRET_KEY", "hmac-secret")
+	t.Setenv("BINANCE_RSA_API_KEY", "rsa-key")
+	t.Setenv("BINANCE_RSA_PRIVATE_KEY_PATH", "missing-rsa.pem")
+	t.Setenv("BINANCE_ED25519_API_KEY", "ed25519-key")
+	t.Setenv("BINANCE_ED25519_PRIVATE_KEY_PATH", "missing-ed25519.pem")
+	
+	// ran collects the config sub-tests testEndpoint started for the required auth
+	ran := func(t *testing.T, required AuthType) []string {
+		var names []string
+		testEndpoint(t, required, "ConfigSelection", func(t *testing.T, client *openapi.APIClient, ctx context.Context) {
+			names = append(names, path.Base(t.Name()))
+		})
+		return names
+	}
+	
+	tests := []struct {
+		name     string
+		allAuth  string
+		required AuthType
+		want     []string
+	}{
+		{"DefaultTrade", "", AuthTypeTRADE, []string{"Ed25519_Authentication"}},
+		{"DefaultPublic", "", AuthTypeNONE, []string{"Public_Endpoints"}},
+		{"AllAuthUserData", "true", AuthTypeUSER_DATA, []string{"HMAC_Authentication", "RSA_Authentication", "Ed25519_Authentication"}},
+		{"AllAuthPublic", "true", AuthTypeNONE, []string{"Public_Endpoints"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(allAuthEnvVar, tt.allAuth)
+			if got := ran(t, tt.required); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected testEndpoint to run %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestReadOnlyMode tests that BINANCE_TEST_READONLY skips TRADE tests and leaves USER_DATA tests running
+func TestReadOnlyMode(t *testing.T) {
+	t.Run("GuardSkips", func(t *testing.T) {
+		t.Setenv(readOnlyEnvVar, "true")
+		reached := false
+		t.Run("TradeTest", func(t *testing.T) {
+			skipIfReadOnly(t)
+			reached = true
+		})
+		if reached {
+			t.Error("Expected the TRADE test body to be skipped in read-only mode")
+		}
+	})
+	
+	t.Run("GuardInactive", func(t *testing.T) {
+		t.Setenv(readOnlyEnvVar, "")
+		reached := false
+		t.Run("TradeTest", func(t *testing.T) {
+			skipIfReadOnly(t)
+			reached = true
+		})
+		if !reached {
+			t.Error("Expected the TRADE test body to run without read-only mode")
+		}
+	})
+	
+	t.Run("Registry", func(t *testing.T) {
+		t.Setenv(readOnlyEnvVar, "true")
+		suite := &TestSuite{Results: make(map[string]TestResult)}
+		suite.initializeTests()
+		userData := 0
+		for _, test := range suite.Tests {
+			blocked := readOnlyBlocks(test.AuthRequired)
+			if blocked != (test.AuthRequired == AuthTypeTRADE) {
+				t.Errorf("%s (auth %d): blocked=%v in read-only mode", test.Name, test.AuthRequired, blocked)
+			}
+			if test.AuthRequired == AuthTypeUSER_DATA {
+				userData++
+			}
+		}
+		if userData == 0 {
+			t.Error("Expected USER_DATA tests in the registry to remain runnable")
+		}
+	})
+}
+
+// TestServerConfig tests that setupClient targets the USD-M futures testnet; no setting points the suite at mainnet
+func TestServerConfig(t *testing.T) {
+	client, _ := setupClient(TestConfig{Name: "Server Config", AuthType: AuthTypeNONE})
+
+	servers := client.GetConfig().Servers
+	if len(servers) == 0 {
+		t.Fatal("Client has no server configuration")
+	}
+	if got, want := servers[0].URL, "https://testnet.binancefuture.com"; got != want {
+		t.Fatalf("Active server URL is %s, want the testnet %s", got, want)
+	}
+}
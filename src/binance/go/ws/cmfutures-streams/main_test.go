@@ -13,6 +13,12 @@ import (
 func TestMain(m *testing.M) {
 	flag.Parse()
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run the tests
 	code := m.Run()
 
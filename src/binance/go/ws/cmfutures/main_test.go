@@ -44,6 +44,12 @@ func TestMain(m *testing.M) {
 		log.Println("To run all tests, set these environment variables with your testnet credentials")
 	}
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run tests
 	exitCode := m.Run()
 	os.Exit(exitCode)
@@ -233,4 +233,4 @@ func TestConnectionResilience(t *testing.T) {
 	}
 
 	t.Log("✅ Connection recovery verification passed")
-}
\ No newline at end of file
+}
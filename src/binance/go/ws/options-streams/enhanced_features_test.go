@@ -2,6 +2,8 @@ package streamstest
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -57,10 +59,7 @@ func TestCombinedStreamEventHandler(t *testing.T) {
 		"ETHUSDT@markPrice", // Mark price stream
 	}
 
-	subscribeCtx, subscribeCancel := context.WithTimeout(ctx, 5*time.Second)
-	defer subscribeCancel()
-
-	err = client.Subscribe(subscribeCtx, streams)
+	err = subscribeAndWait(ctx, client.Subscribe, streams, subscribeAckTimeout(), subscribeAttempts())
 	if err != nil {
 		t.Fatalf("Failed to subscribe to streams: %v", err)
 	}
@@ -75,6 +74,134 @@ func TestCombinedStreamEventHandler(t *testing.T) {
 	}
 }
 
+// TestCombinedStreamTypedDecode verifies the SDK decodes combined-stream wrappers into the typed
+// event for each stream: the typed handlers registered on a combined connection must receive
+// index, ticker and trade events with the expected fields populated
+func TestCombinedStreamTypedDecode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping combined stream tests in short mode")
+	}
+
+	tickerSymbol, err := selectATMSymbol("BTC", "C")
+	if err != nil {
+		t.Skipf("No active BTC call options available for ticker stream: %v", err)
+	}
+
+	indexStream := "ETHUSDT@index"
+	tickerStream := tickerSymbol + "@ticker"
+	tradeStream := "BTC@trade"
+
+	client := optionsstreams.NewClient()
+	if err := client.SetActiveServer("mainnet1"); err != nil {
+		t.Fatalf("Failed to set mainnet server: %v", err)
+	}
+
+	// Keep the first typed event each handler receives
+	var (
+		mu     sync.Mutex
+		index  *models.IndexPriceEvent
+		ticker *models.TickerEvent
+		trade  *models.TradeEvent
+	)
+	client.HandleIndexPriceEvent(func(event *models.IndexPriceEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if index == nil {
+			index = event
+		}
+		return nil
+	})
+	client.HandleTickerEvent(func(event *models.TickerEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if ticker == nil {
+			ticker = event
+		}
+		return nil
+	})
+	client.HandleTradeEvent(func(event *models.TradeEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if trade == nil {
+			trade = event
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if err := client.ConnectToCombinedStreams(ctx, ""); err != nil {
+		t.Fatalf("Failed to connect to combined streams: %v", err)
+	}
+	defer client.Disconnect()
+
+	streams := []string{indexStream, tickerStream, tradeStream}
+	if err := subscribeAndWait(ctx, client.Subscribe, streams, subscribeAckTimeout(), subscribeAttempts()); err != nil {
+		t.Fatalf("Failed to subscribe to streams: %v", err)
+	}
+
+	// Index and ticker push continuously; trades only arrive when the underlying trades
+	deadline := time.Now().Add(eventWait())
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		done := index != nil && ticker != nil && trade != nil
+		mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	t.Run("IndexPriceEvent", func(t *testing.T) {
+		if index == nil {
+			t.Fatalf("HandleIndexPriceEvent received no typed event for %s on the combined connection", indexStream)
+		}
+		if index.EventType != "index" {
+			t.Errorf("Expected EventType index, got %q", index.EventType)
+		}
+		if index.Symbol != "ETHUSDT" {
+			t.Errorf("Expected Symbol ETHUSDT, got %q", index.Symbol)
+		}
+		if index.EventTime <= 0 {
+			t.Errorf("Expected EventTime to be set, got %d", index.EventTime)
+		}
+	})
+
+	t.Run("TickerEvent", func(t *testing.T) {
+		if ticker == nil {
+			t.Fatalf("HandleTickerEvent received no typed event for %s on the combined connection", tickerStream)
+		}
+		if ticker.EventType != "24hrTicker" {
+			t.Errorf("Expected EventType 24hrTicker, got %q", ticker.EventType)
+		}
+		if ticker.Symbol != tickerSymbol {
+			t.Errorf("Expected Symbol %s, got %q", tickerSymbol, ticker.Symbol)
+		}
+		if ticker.EventTime <= 0 {
+			t.Errorf("Expected EventTime to be set, got %d", ticker.EventTime)
+		}
+	})
+
+	t.Run("TradeEvent", func(t *testing.T) {
+		if trade == nil {
+			t.Skipf("No trades on %s within %v - quiet market", tradeStream, eventWait())
+		}
+		if trade.EventType != "trade" {
+			t.Errorf("Expected EventType trade, got %q", trade.EventType)
+		}
+		if !strings.HasPrefix(trade.Symbol, "BTC-") || !validateSymbolFormat(trade.Symbol) {
+			t.Errorf("Expected a BTC option symbol, got %q", trade.Symbol)
+		}
+		if trade.TradeTime <= 0 {
+			t.Errorf("Expected TradeTime to be set, got %d", trade.TradeTime)
+		}
+	})
+}
+
 // TestStreamErrorHandler tests error handling functionality
 func TestStreamErrorHandler(t *testing.T) {
 	if testing.Short() {
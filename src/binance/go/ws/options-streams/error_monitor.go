@@ -1,70 +1,144 @@
 package streamstest
 
 import (
+	"io"
 	"log"
-	"os"
 	"strings"
 	"sync"
 	"time"
 )
 
-// ErrorMonitor captures log messages that indicate SDK parsing errors
+// ErrorMonitor captures log messages that indicate SDK parsing errors.
+// Each test should use its own monitor from NewErrorMonitor so concurrent
+// tests keep separate buffers.
 type ErrorMonitor struct {
-	errors []string
-	mu     sync.RWMutex
-	originalOutput *os.File
+	errors   []string
+	mu       sync.RWMutex
 	isActive bool
+	// streams scopes the monitor to the streams its test subscribed to; empty means every message
+	streams []string
 }
 
-var globalErrorMonitor = &ErrorMonitor{}
+// NewErrorMonitor creates an inactive monitor with its own error buffer. When streams are
+// given, errors naming another monitor's stream are left to that monitor; errors naming no
+// monitored stream cannot be attributed and reach every monitor.
+func NewErrorMonitor(streams ...string) *ErrorMonitor {
+	return &ErrorMonitor{streams: streams}
+}
+
+// mentions reports whether message names one of the monitor's streams
+func (em *ErrorMonitor) mentions(message string) bool {
+	lower := strings.ToLower(message)
+	for _, stream := range em.streams {
+		if strings.Contains(lower, strings.ToLower(stream)) {
+			return true
+		}
+	}
+	return false
+}
+
+var globalErrorMonitor = NewErrorMonitor()
+
+// logFanout is installed once as the standard logger output. It forwards every
+// write to the original output and to the active monitors it belongs to, so
+// monitors never swap the global logger and cannot clobber each other's capture.
+// The SDK logs through the standard logger without naming its client, so a
+// record is attributed by the stream it mentions.
+type logFanout struct {
+	mu       sync.Mutex
+	original io.Writer
+	monitors map[*ErrorMonitor]struct{}
+}
+
+var (
+	sharedLogFanout     *logFanout
+	sharedLogFanoutOnce sync.Once
+)
+
+// getLogFanout installs the shared fan-out writer on first use
+func getLogFanout() *logFanout {
+	sharedLogFanoutOnce.Do(func() {
+		sharedLogFanout = &logFanout{
+			original: log.Writer(),
+			monitors: make(map[*ErrorMonitor]struct{}),
+		}
+		log.SetOutput(sharedLogFanout)
+	})
+	return sharedLogFanout
+}
+
+// Write forwards a log record to the original output and to the active monitors it belongs to:
+// unscoped monitors, and either the scoped monitors whose streams it mentions or, when it
+// mentions none, every scoped monitor
+func (f *logFanout) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	message := string(p)
+	attributed := false
+	for em := range f.monitors {
+		if em.mentions(message) {
+			attributed = true
+			break
+		}
+	}
+	for em := range f.monitors {
+		if len(em.streams) == 0 || !attributed || em.mentions(message) {
+			em.capture(message)
+		}
+	}
+	return f.original.Write(p)
+}
+
+func (f *logFanout) add(em *ErrorMonitor) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.monitors[em] = struct{}{}
+}
+
+func (f *logFanout) remove(em *ErrorMonitor) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.monitors, em)
+}
+
+// active returns the number of monitors currently capturing
+func (f *logFanout) active() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.monitors)
+}
+
+// capture records message if it is an SDK parsing error
+func (em *ErrorMonitor) capture(message string) {
+	if !em.isSDKError(message) {
+		return
+	}
+	em.mu.Lock()
+	defer em.mu.Unlock()
+	if em.isActive {
+		em.errors = append(em.errors, strings.TrimSpace(message))
+	}
+}
 
 // StartErrorMonitoring starts monitoring for SDK parsing errors
 func (em *ErrorMonitor) StartErrorMonitoring() {
 	em.mu.Lock()
-	defer em.mu.Unlock()
-	
 	if em.isActive {
+		em.mu.Unlock()
 		return // Already monitoring
 	}
-	
 	em.errors = make([]string, 0)
 	em.isActive = true
-	
-	// Override log output to capture SDK errors
-	em.originalOutput = log.Writer().(*os.File)
-	
-	// Create a custom writer that captures errors
-	pr, pw, _ := os.Pipe()
-	log.SetOutput(pw)
-	
-	// Start goroutine to read and filter log messages
-	go func() {
-		buffer := make([]byte, 1024)
-		for {
-			n, err := pr.Read(buffer)
-			if err != nil {
-				break
-			}
-			
-			message := string(buffer[:n])
-			
-			// Check if this is an SDK parsing error
-			if em.isSDKError(message) {
-				em.mu.Lock()
-				em.errors = append(em.errors, strings.TrimSpace(message))
-				em.mu.Unlock()
-			}
-			
-			// Also write to original output
-			if em.originalOutput != nil {
-				em.originalOutput.Write(buffer[:n])
-			}
-		}
-	}()
+	em.mu.Unlock()
+
+	getLogFanout().add(em)
 }
 
 // StopErrorMonitoring stops monitoring and returns captured errors
 func (em *ErrorMonitor) StopErrorMonitoring() []string {
+	getLogFanout().remove(em)
+
 	em.mu.Lock()
 	defer em.mu.Unlock()
 	
@@ -74,11 +148,6 @@ func (em *ErrorMonitor) StopErrorMonitoring() []string {
 	
 	em.isActive = false
 	
-	// Restore original log output
-	if em.originalOutput != nil {
-		log.SetOutput(em.originalOutput)
-	}
-	
 	// Return captured errors
 	result := make([]string, len(em.errors))
 	copy(result, em.errors)
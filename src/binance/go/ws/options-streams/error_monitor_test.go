@@ -0,0 +1,93 @@
+package streamstest
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestErrorMonitorConcurrentCapture runs two capture-using suites in parallel and checks
+// that each keeps its own buffer, sees only its own stream's errors, and that stopping one
+// does not end the other's capture.
+// Run with -race to catch unsynchronised access to the shared logger.
+func TestErrorMonitorConcurrentCapture(t *testing.T) {
+	suites := []string{"suite-a", "suite-b"}
+
+	// Both suites start capturing before either logs or stops
+	var started sync.WaitGroup
+	started.Add(len(suites))
+
+	for _, name := range suites {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			monitor := NewErrorMonitor(name)
+			monitor.StartErrorMonitoring()
+			started.Done()
+			started.Wait()
+
+			for i := 0; i < 50; i++ {
+				log.Printf("Failed to parse %s message %d", name, i)
+			}
+			log.Printf("routine log line from %s", name)
+
+			errors := monitor.StopErrorMonitoring()
+			own := 0
+			for _, message := range errors {
+				if strings.Contains(message, "routine log line") {
+					t.Errorf("Non-error log line was captured: %s", message)
+				}
+				if strings.Contains(message, name) {
+					own++
+				} else {
+					t.Errorf("Another suite's error was captured: %s", message)
+				}
+			}
+			if own != 50 {
+				t.Errorf("Expected 50 captured errors for %s, got %d (of %d total)", name, own, len(errors))
+			}
+
+			// A stopped monitor no longer receives messages
+			log.Printf("Failed to parse %s after stop", name)
+			if remaining := monitor.GetCurrentErrors(); len(remaining) != 0 {
+				t.Errorf("Expected no errors after stop, got %v", remaining)
+			}
+		})
+	}
+
+	t.Cleanup(func() {
+		if active := getLogFanout().active(); active != 0 {
+			t.Errorf("Expected no active monitors after both suites stopped, got %d", active)
+		}
+	})
+}
+
+// TestErrorMonitorAttribution checks how a record is routed between scoped and unscoped monitors
+func TestErrorMonitorAttribution(t *testing.T) {
+	scopedA := NewErrorMonitor("btc-251226-100000-c@trade")
+	scopedB := NewErrorMonitor("eth-251226-4000-p@trade")
+	unscoped := NewErrorMonitor()
+	for _, monitor := range []*ErrorMonitor{scopedA, scopedB, unscoped} {
+		monitor.StartErrorMonitoring()
+	}
+
+	log.Printf("Failed to parse BTC-251226-100000-C@trade message")
+	log.Printf("Failed to parse a message without a stream")
+
+	gotA := scopedA.StopErrorMonitoring()
+	gotB := scopedB.StopErrorMonitoring()
+	gotUnscoped := unscoped.StopErrorMonitoring()
+
+	// The attributed error goes to its stream's monitor only; the unattributed one to all
+	if len(gotA) != 2 {
+		t.Errorf("Expected the attributed and unattributed errors for the BTC monitor, got %v", gotA)
+	}
+	if len(gotB) != 1 || !strings.Contains(gotB[0], "without a stream") {
+		t.Errorf("Expected only the unattributed error for the ETH monitor, got %v", gotB)
+	}
+	if len(gotUnscoped) != 2 {
+		t.Errorf("Expected every error for the unscoped monitor, got %v", gotUnscoped)
+	}
+}
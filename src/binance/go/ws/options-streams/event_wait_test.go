@@ -0,0 +1,88 @@
+package streamstest
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Default event wait durations, overridable via BINANCE_TEST_EVENT_WAIT and
+// BINANCE_TEST_EVENT_WAIT_LONG
+const (
+	defaultEventWait     = 20 * time.Second
+	defaultEventWaitLong = 90 * time.Second
+)
+
+// warnedEnv tracks which invalid environment settings were already logged
+var warnedEnv sync.Map
+
+// durationFromEnv reads a Go duration (e.g. "45s", "2m") from the environment,
+// falling back to the default when the variable is unset or invalid
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value)
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("duration must be positive")
+	}
+	if err != nil {
+		if _, warned := warnedEnv.LoadOrStore(key, true); !warned {
+			log.Printf("Invalid %s=%q (%v), using default %s", key, value, err, fallback)
+		}
+		return fallback
+	}
+	return d
+}
+
+// eventWait returns how long to wait for events on regularly pushed streams
+func eventWait() time.Duration {
+	return durationFromEnv("BINANCE_TEST_EVENT_WAIT", defaultEventWait)
+}
+
+// eventWaitLong returns how long to wait for events on slow streams such as open interest
+func eventWaitLong() time.Duration {
+	return durationFromEnv("BINANCE_TEST_EVENT_WAIT_LONG", defaultEventWaitLong)
+}
+
+// TestEventWaitConfiguration tests that event wait durations honour the environment
+func TestEventWaitConfiguration(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		t.Setenv("BINANCE_TEST_EVENT_WAIT", "")
+		t.Setenv("BINANCE_TEST_EVENT_WAIT_LONG", "")
+
+		if got := eventWait(); got != defaultEventWait {
+			t.Errorf("eventWait() = %v, want default %v", got, defaultEventWait)
+		}
+		if got := eventWaitLong(); got != defaultEventWaitLong {
+			t.Errorf("eventWaitLong() = %v, want default %v", got, defaultEventWaitLong)
+		}
+	})
+
+	t.Run("Overrides", func(t *testing.T) {
+		t.Setenv("BINANCE_TEST_EVENT_WAIT", "45s")
+		t.Setenv("BINANCE_TEST_EVENT_WAIT_LONG", "3m")
+
+		if got := eventWait(); got != 45*time.Second {
+			t.Errorf("eventWait() = %v, want 45s", got)
+		}
+		if got := eventWaitLong(); got != 3*time.Minute {
+			t.Errorf("eventWaitLong() = %v, want 3m", got)
+		}
+	})
+
+	t.Run("InvalidFallsBack", func(t *testing.T) {
+		for _, value := range []string{"soon", "30", "-5s", "0s"} {
+			t.Setenv("BINANCE_TEST_EVENT_WAIT", value)
+			if got := eventWait(); got != defaultEventWait {
+				t.Errorf("eventWait() with %q = %v, want default %v", value, got, defaultEventWait)
+			}
+		}
+	})
+}
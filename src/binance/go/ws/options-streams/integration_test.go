@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -399,8 +401,8 @@ func (stc *StreamTestClient) WaitForEventsByType(eventType string, count int, ti
 
 // Subscribe to streams
 func (stc *StreamTestClient) Subscribe(ctx context.Context, streams []string) error {
-	// Use the real SDK Subscribe method
-	err := stc.client.Subscribe(ctx, streams)
+	// Use the real SDK Subscribe method, resending if the ACK is dropped
+	err := subscribeAndWait(ctx, stc.client.Subscribe, streams, subscribeAckTimeout(), subscribeAttempts())
 	if err != nil {
 		return err
 	}
@@ -492,6 +494,92 @@ func getTestConfig() TestConfig {
 	}
 }
 
+// Subscribe ACK retry defaults, overridable via BINANCE_TEST_SUBSCRIBE_ACK_TIMEOUT and
+// BINANCE_TEST_SUBSCRIBE_ATTEMPTS
+const (
+	defaultSubscribeAckTimeout = 5 * time.Second
+	defaultSubscribeAttempts   = 3
+)
+
+// subscribeFunc sends a SUBSCRIBE request and blocks until it is acknowledged
+type subscribeFunc func(ctx context.Context, streams []string) error
+
+// subscribeAckTimeout returns how long to wait for a SUBSCRIBE ACK before retrying
+func subscribeAckTimeout() time.Duration {
+	return durationFromEnv("BINANCE_TEST_SUBSCRIBE_ACK_TIMEOUT", defaultSubscribeAckTimeout)
+}
+
+// subscribeAttempts returns how many SUBSCRIBE requests to send before giving up
+func subscribeAttempts() int {
+	key := "BINANCE_TEST_SUBSCRIBE_ATTEMPTS"
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultSubscribeAttempts
+	}
+
+	n, err := strconv.Atoi(value)
+	if err == nil && n <= 0 {
+		err = fmt.Errorf("attempts must be positive")
+	}
+	if err != nil {
+		if _, warned := warnedEnv.LoadOrStore(key, true); !warned {
+			log.Printf("Invalid %s=%q (%v), using default %d", key, value, err, defaultSubscribeAttempts)
+		}
+		return defaultSubscribeAttempts
+	}
+	return n
+}
+
+// defaultMarkPriceOutlierFraction is the share of symbols whose WS and REST marks may
+// disagree beyond tolerance before the mark price consistency test fails
+const defaultMarkPriceOutlierFraction = 0.2
+
+// markPriceOutlierFraction returns the tolerated outlier share, overridable via
+// BINANCE_TEST_MARK_PRICE_OUTLIER_FRACTION
+func markPriceOutlierFraction() float64 {
+	key := "BINANCE_TEST_MARK_PRICE_OUTLIER_FRACTION"
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultMarkPriceOutlierFraction
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err == nil && (f < 0 || f > 1) {
+		err = fmt.Errorf("fraction must be between 0 and 1")
+	}
+	if err != nil {
+		if _, warned := warnedEnv.LoadOrStore(key, true); !warned {
+			log.Printf("Invalid %s=%q (%v), using default %.2f", key, value, err, defaultMarkPriceOutlierFraction)
+		}
+		return defaultMarkPriceOutlierFraction
+	}
+	return f
+}
+
+// subscribeAndWait sends SUBSCRIBE and, if no ACK arrives within timeout, sends it again as a
+// fresh request (the SDK assigns a new id per call), up to attempts times. An ACK carrying an
+// error is returned immediately since repeating the same request cannot fix it.
+func subscribeAndWait(ctx context.Context, subscribe subscribeFunc, streams []string, timeout time.Duration, attempts int) error {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		err = subscribe(attemptCtx, streams)
+		ackMissing := attemptCtx.Err() != nil
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		if !ackMissing || ctx.Err() != nil {
+			return err
+		}
+		if attempt < attempts {
+			log.Printf("No SUBSCRIBE ACK for %v within %s (attempt %d/%d), retrying", streams, timeout, attempt, attempts)
+		}
+	}
+	return fmt.Errorf("no SUBSCRIBE ACK for %v after %d attempts: %w", streams, attempts, err)
+}
+
 // TB interface for both testing.T and testing.B
 type TB interface {
 	Fatalf(format string, args ...interface{})
@@ -643,11 +731,13 @@ func testStreamSubscriptionWithGracefulTimeout(t *testing.T, streamName string,
 
 	ctx := context.Background()
 
-	// Start error monitoring to detect SDK parsing errors
-	StartErrorMonitoring()
+	// Start error monitoring to detect SDK parsing errors; the monitor is per-test and
+	// scoped to its stream so parallel tests do not share or reset each other's capture
+	monitor := NewErrorMonitor(streamName)
+	monitor.StartErrorMonitoring()
 	defer func() {
 		// Check for SDK errors at the end of the test
-		errors := StopErrorMonitoring()
+		errors := monitor.StopErrorMonitoring()
 		if len(errors) > 0 {
 			t.Fatalf("SDK parsing errors detected:\n%s", strings.Join(errors, "\n"))
 		}
@@ -655,7 +745,7 @@ func testStreamSubscriptionWithGracefulTimeout(t *testing.T, streamName string,
 
 	// Clear any previous events and errors
 	client.ClearEvents()
-	ClearErrors()
+	monitor.ClearErrors()
 
 	// Subscribe to stream (using our simplified approach)
 	if err := client.Subscribe(ctx, []string{streamName}); err != nil {
@@ -722,15 +812,15 @@ func testStreamSubscriptionWithGracefulTimeout(t *testing.T, streamName string,
 	
 	// Check for immediate SDK errors before waiting for events
 	time.Sleep(2 * time.Second) // Give time for potential parsing errors
-	immediateErrors := GetCurrentErrors()
+	immediateErrors := monitor.GetCurrentErrors()
 	if len(immediateErrors) > 0 {
 		t.Fatalf("SDK parsing errors occurred immediately after subscription:\n%s", strings.Join(immediateErrors, "\n"))
 	}
 
-	err := client.WaitForEventsByType(eventType, eventCount, 20*time.Second)
+	err := client.WaitForEventsByType(eventType, eventCount, eventWait())
 	if err != nil {
 		// Check if timeout was due to SDK parsing errors
-		parsingErrors := GetCurrentErrors()
+		parsingErrors := monitor.GetCurrentErrors()
 		if len(parsingErrors) > 0 {
 			t.Fatalf("SDK parsing errors occurred during event processing:\n%s", strings.Join(parsingErrors, "\n"))
 		}
@@ -748,6 +838,15 @@ func testStreamSubscriptionWithGracefulTimeout(t *testing.T, streamName string,
 		if len(events) > 0 {
 			t.Logf("First event type: %T", events[0])
 		}
+		
+		// Array-shaped events must never arrive empty
+		validateArrayEvents(t, streamName, events)
+		
+		// Trade events must be emitted no earlier than the trade they report
+		validateTradeTimestamps(t, events)
+		
+		// Events must reference well-formed, unexpired contracts
+		validateEventSymbols(t, events)
 	}
 
 	// Unsubscribe
@@ -771,6 +870,51 @@ func testStreamSubscriptionWithGracefulTimeout(t *testing.T, streamName string,
 	}
 }
 
+// assertArrayEventNonEmpty fails the test if an array-shaped event arrived with no elements
+func assertArrayEventNonEmpty(t *testing.T, length int, name string) {
+	t.Helper()
+	if length == 0 {
+		t.Fatalf("%s event arrived as an empty array", name)
+	}
+}
+
+// arrayEventLength returns the element count of array-shaped events (markPrice, tickerByUnderlying, openInterest)
+func arrayEventLength(event interface{}) (int, string, bool) {
+	switch e := event.(type) {
+	case *models.MarkPriceEvent:
+		return len(*e), "MarkPriceEvent", true
+	case *models.TickerByUnderlyingEvent:
+		return len(*e), "TickerByUnderlyingEvent", true
+	case *models.OpenInterestEvent:
+		return len(*e), "OpenInterestEvent", true
+	default:
+		return 0, "", false
+	}
+}
+
+// validateArrayEvents checks that array-shaped events are non-empty. An empty array is only
+// tolerated (as a skip) when REST reports no active contracts for the stream's underlying.
+func validateArrayEvents(t *testing.T, streamName string, events []interface{}) {
+	t.Helper()
+
+	for _, event := range events {
+		length, name, isArray := arrayEventLength(event)
+		if !isArray {
+			continue
+		}
+
+		if length == 0 {
+			underlying := strings.SplitN(streamName, "@", 2)[0]
+			if !isUnderlyingLive(underlying) {
+				t.Skipf("%s event for %s was empty and REST reports no active contracts - quiet market", name, streamName)
+				return
+			}
+		}
+
+		assertArrayEventNonEmpty(t, length, name)
+	}
+}
+
 // Helper function to test stream connection with graceful timeout handling (legacy)
 func testStreamConnectionWithGracefulTimeout(t *testing.T, streamName string, timeoutMessage string) {
 	if testing.Short() {
@@ -818,4 +962,41 @@ func testStreamConnectionWithGracefulTimeout(t *testing.T, streamName string, ti
 			t.Logf("First response type: %T", responses[0])
 		}
 	}
-}
\ No newline at end of file
+}
+
+// assertOptionNotExpired fails the test if the option's embedded expiry date is before today (UTC)
+func assertOptionNotExpired(t *testing.T, symbol string) {
+	t.Helper()
+	expiry, err := parseOptionExpiry(symbol)
+	if err != nil {
+		t.Errorf("Cannot check expiry: %v", err)
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if expiry.Before(today) {
+		t.Errorf("Option %s expired on %s - stale symbol selected", symbol, expiry.Format("2006-01-02"))
+	}
+}
+
+// validateEventSymbols checks the format and expiry of the option symbol carried by each event
+func validateEventSymbols(t *testing.T, events []interface{}) {
+	t.Helper()
+	for _, event := range events {
+		var symbol string
+		switch e := event.(type) {
+		case *models.TradeEvent:
+			symbol = e.Symbol
+		case *models.TickerEvent:
+			symbol = e.Symbol
+		default:
+			continue
+		}
+
+		if !validateSymbolFormat(symbol) {
+			t.Errorf("Event %T carried malformed option symbol %q", event, symbol)
+			continue
+		}
+		assertOptionNotExpired(t, symbol)
+	}
+}
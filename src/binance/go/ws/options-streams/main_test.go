@@ -13,6 +13,12 @@ import (
 func TestMain(m *testing.M) {
 	flag.Parse()
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run the tests
 	code := m.Run()
 
@@ -115,6 +121,8 @@ func TestFullIntegrationSuite(t *testing.T) {
 		{"MarkPriceStream", TestMarkPriceStream, true},
 		{"NewSymbolInfoStream", TestNewSymbolInfoStream, true},
 		{"OpenInterestStream", TestOpenInterestStream, true},
+		{"OpenInterestRESTConsistency", TestOpenInterestRESTConsistency, false},
+		{"MarkPriceRESTConsistency", TestMarkPriceRESTConsistency, false},
 		{"PartialDepthStream", TestPartialDepthStream, true},
 		{"TickerStream", TestTickerStream, true},
 		{"TickerByUnderlyingStream", TestTickerByUnderlyingStream, true},
@@ -123,6 +131,7 @@ func TestFullIntegrationSuite(t *testing.T) {
 		// Advanced feature tests
 		{"MultipleStreamTypes", TestMultipleStreamTypes, true},
 		{"CombinedStreamEventHandler", TestCombinedStreamEventHandler, true},
+		{"CombinedStreamTypedDecode", TestCombinedStreamTypedDecode, true},
 		{"StreamErrorHandler", TestStreamErrorHandler, true},
 
 		// Performance tests
@@ -1,7 +1,12 @@
 package streamstest
 
 import (
+	"context"
+	"sort"
+	"strconv"
 	"testing"
+
+	"github.com/openxapi/binance-go/ws/options-streams/models"
 )
 
 // TestIndexPriceStream tests index price stream functionality
@@ -153,6 +158,8 @@ func TestTradeStream(t *testing.T) {
 		return
 	}
 	
+	assertOptionNotExpired(t, symbol)
+	
 	streamName := symbol + "@trade"
 	t.Logf("Testing trade stream with active symbol: %s", streamName)
 	
@@ -341,4 +348,186 @@ func TestMultipleStreamTypes(t *testing.T) {
 	}
 
 	t.Log("✅ Multiple stream types testing completed")
-}
\ No newline at end of file
+}
+
+// TestOpenInterestRESTConsistency cross-checks OpenInterestEvent values against the REST open interest endpoint
+func TestOpenInterestRESTConsistency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping open interest consistency test in short mode")
+	}
+
+	expirations, err := getActiveExpirationDates("ETH")
+	if err != nil || len(expirations) == 0 {
+		t.Skipf("No active ETH expiration dates available: %v", err)
+		return
+	}
+
+	expiration := expirations[0]
+	streamName := "ETH@openInterest@" + expiration
+
+	client, isDedicated := setupTestClient(t)
+	if isDedicated {
+		defer client.Disconnect()
+	}
+
+	ctx := context.Background()
+	client.ClearEvents()
+
+	if err := client.Subscribe(ctx, []string{streamName}); err != nil {
+		t.Fatalf("Failed to subscribe to %s: %v", streamName, err)
+	}
+	defer client.Unsubscribe(ctx, []string{streamName})
+
+	// Open interest is only pushed about once a minute, so wait well past one cycle
+	if err := client.WaitForEventsByType("openInterest", 1, eventWaitLong()); err != nil {
+		t.Skipf("No open interest event received for %s within the wait window: %v", streamName, err)
+		return
+	}
+
+	var wsEvent *models.OpenInterestEvent
+	for _, event := range client.GetEventsByType("openInterest") {
+		if e, ok := event.(*models.OpenInterestEvent); ok && e != nil {
+			wsEvent = e
+			break
+		}
+	}
+	if wsEvent == nil {
+		t.Fatal("Recorded openInterest event has unexpected type")
+	}
+	assertArrayEventNonEmpty(t, len(*wsEvent), "OpenInterestEvent")
+
+	restOpenInterest, err := getRESTOpenInterest("ETH", expiration)
+	if err != nil {
+		t.Fatalf("Failed to fetch REST open interest: %v", err)
+	}
+
+	// Generous tolerance: the two snapshots can be up to a full update cycle apart
+	const relTolerance = 0.10
+	const absTolerance = 5.0
+
+	compared := 0
+	for _, item := range *wsEvent {
+		restValue, ok := restOpenInterest[item.Symbol]
+		if !ok {
+			continue
+		}
+
+		wsValue, err := strconv.ParseFloat(item.OpenInterestInContracts, 64)
+		if err != nil {
+			t.Errorf("Failed to parse WS OpenInterestInContracts %q for %s: %v", item.OpenInterestInContracts, item.Symbol, err)
+			continue
+		}
+
+		compared++
+		diff := abs(wsValue - restValue)
+		if diff > absTolerance && diff > restValue*relTolerance {
+			t.Errorf("Open interest mismatch for %s: WS=%.4f REST=%.4f (diff %.4f)", item.Symbol, wsValue, restValue, diff)
+		}
+	}
+
+	if compared == 0 {
+		t.Skipf("No overlapping symbols between WS (%d) and REST (%d) open interest for %s", len(*wsEvent), len(restOpenInterest), expiration)
+		return
+	}
+
+	t.Logf("✅ Compared open interest for %d symbols (expiration %s) between WS and REST", compared, expiration)
+}
+
+// TestMarkPriceRESTConsistency cross-checks every mark in an underlying's MarkPriceEvent array
+// against the REST mark price endpoint, failing only when too many symbols are outliers
+func TestMarkPriceRESTConsistency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping mark price consistency test in short mode")
+	}
+
+	const underlying = "ETH"
+	streamName := underlying + "@markPrice"
+
+	client, isDedicated := setupTestClient(t)
+	if isDedicated {
+		defer client.Disconnect()
+	}
+
+	ctx := context.Background()
+	client.ClearEvents()
+
+	if err := client.Subscribe(ctx, []string{streamName}); err != nil {
+		t.Fatalf("Failed to subscribe to %s: %v", streamName, err)
+	}
+	defer client.Unsubscribe(ctx, []string{streamName})
+
+	if err := client.WaitForEventsByType("markPrice", 1, eventWait()); err != nil {
+		t.Skipf("No mark price event received for %s within the wait window: %v", streamName, err)
+		return
+	}
+
+	var wsEvent *models.MarkPriceEvent
+	for _, event := range client.GetEventsByType("markPrice") {
+		if e, ok := event.(*models.MarkPriceEvent); ok && e != nil {
+			wsEvent = e
+			break
+		}
+	}
+	if wsEvent == nil {
+		t.Fatal("Recorded markPrice event has unexpected type")
+	}
+	assertArrayEventNonEmpty(t, len(*wsEvent), "MarkPriceEvent")
+
+	restMarkPrices, err := getRESTMarkPrices(underlying)
+	if err != nil {
+		t.Fatalf("Failed to fetch REST mark prices: %v", err)
+	}
+
+	// Marks are recomputed every second, and quiet deep out-of-the-money options have tiny
+	// prices, so a symbol is only an outlier when it misses both tolerances
+	const relTolerance = 0.05
+	const absTolerance = 1.0
+	maxOutlierFraction := markPriceOutlierFraction()
+
+	var deviations []float64
+	var outliers []string
+	for _, item := range *wsEvent {
+		restValue, ok := restMarkPrices[item.Symbol]
+		if !ok {
+			continue
+		}
+
+		wsValue, err := strconv.ParseFloat(item.MarkPrice, 64)
+		if err != nil {
+			t.Errorf("Failed to parse WS MarkPrice %q for %s: %v", item.MarkPrice, item.Symbol, err)
+			continue
+		}
+
+		diff := abs(wsValue - restValue)
+		deviation := 0.0
+		if restValue != 0 {
+			deviation = diff / restValue
+		}
+		deviations = append(deviations, deviation)
+		if diff > absTolerance && deviation > relTolerance {
+			outliers = append(outliers, item.Symbol)
+			t.Logf("Mark price deviation for %s: WS=%.4f REST=%.4f (%.2f%%)", item.Symbol, wsValue, restValue, deviation*100)
+		}
+	}
+
+	if len(deviations) == 0 {
+		t.Skipf("No overlapping symbols between WS (%d) and REST (%d) mark prices for %s", len(*wsEvent), len(restMarkPrices), underlying)
+		return
+	}
+
+	sort.Float64s(deviations)
+	percentile := func(p float64) float64 {
+		return deviations[int(p*float64(len(deviations)-1))] * 100
+	}
+	t.Logf("Mark price deviation over %d symbols: min=%.3f%% p50=%.3f%% p90=%.3f%% max=%.3f%%",
+		len(deviations), percentile(0), percentile(0.5), percentile(0.9), percentile(1))
+
+	outlierFraction := float64(len(outliers)) / float64(len(deviations))
+	if outlierFraction > maxOutlierFraction {
+		t.Errorf("%d of %d symbols (%.0f%%) miss both the %.0f%% and the %.2f absolute mark price tolerances, more than the allowed %.0f%%: %v",
+			len(outliers), len(deviations), outlierFraction*100, relTolerance*100, absTolerance, maxOutlierFraction*100, outliers)
+		return
+	}
+
+	t.Logf("✅ Compared mark prices for %d %s symbols between WS and REST (%d outliers)", len(deviations), underlying, len(outliers))
+}
@@ -0,0 +1,160 @@
+package streamstest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	optionsstreams "github.com/openxapi/binance-go/ws/options-streams"
+)
+
+// stubAckServer is a local WebSocket server that answers SUBSCRIBE requests and can be
+// told to drop the first ACK or to reply with an error
+type stubAckServer struct {
+	server    *httptest.Server
+	dropFirst bool
+	errorAck  bool
+
+	mu  sync.Mutex
+	ids []string
+}
+
+// stubRequest is the subset of a SUBSCRIBE request the stub needs
+type stubRequest struct {
+	Method string          `json:"method"`
+	ID     json.RawMessage `json:"id"`
+}
+
+func newStubAckServer(t *testing.T, dropFirst, errorAck bool) *stubAckServer {
+	t.Helper()
+	stub := &stubAckServer{dropFirst: dropFirst, errorAck: errorAck}
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var req stubRequest
+			if err := json.Unmarshal(message, &req); err != nil || req.Method != "SUBSCRIBE" {
+				continue
+			}
+
+			stub.mu.Lock()
+			stub.ids = append(stub.ids, string(req.ID))
+			seen := len(stub.ids)
+			stub.mu.Unlock()
+
+			if stub.dropFirst && seen == 1 {
+				continue // Simulate a lost ACK
+			}
+
+			response := map[string]interface{}{"id": req.ID, "result": nil}
+			if stub.errorAck {
+				response = map[string]interface{}{
+					"id":    req.ID,
+					"error": map[string]interface{}{"code": 2, "msg": "Invalid request"},
+				}
+			}
+			if err := conn.WriteJSON(response); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(stub.server.Close)
+	return stub
+}
+
+// requestIds returns the ids of the SUBSCRIBE requests received so far
+func (s *stubAckServer) requestIds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.ids...)
+}
+
+// connectToStub points a fresh SDK client at the stub server
+func connectToStub(t *testing.T, stub *stubAckServer) *optionsstreams.Client {
+	t.Helper()
+	client := optionsstreams.NewClient()
+	url := "ws" + strings.TrimPrefix(stub.server.URL, "http") + "/eoptions/ws"
+	if err := client.AddOrUpdateServer("stub", url, "Stub Server", "Local ACK stub"); err != nil {
+		t.Fatalf("Failed to add stub server: %v", err)
+	}
+	if err := client.SetActiveServer("stub"); err != nil {
+		t.Fatalf("Failed to activate stub server: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect to stub server: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect() })
+	return client
+}
+
+// TestSubscribeAndWaitRetriesDroppedAck verifies a dropped ACK is retried with a fresh request id
+func TestSubscribeAndWaitRetriesDroppedAck(t *testing.T) {
+	stub := newStubAckServer(t, true, false)
+	client := connectToStub(t, stub)
+
+	err := subscribeAndWait(context.Background(), client.Subscribe, []string{"BTCUSDT@index"}, 500*time.Millisecond, 3)
+	if err != nil {
+		t.Fatalf("Expected subscribe to succeed after retry, got: %v", err)
+	}
+
+	ids := stub.requestIds()
+	if len(ids) != 2 {
+		t.Fatalf("Expected 2 SUBSCRIBE requests (one dropped, one acknowledged), got %d", len(ids))
+	}
+	if ids[0] == ids[1] {
+		t.Errorf("Retry reused request id %s; expected a fresh id", ids[0])
+	}
+}
+
+// TestSubscribeAndWaitFailsOnErrorAck verifies an ACK carrying an error is not retried
+func TestSubscribeAndWaitFailsOnErrorAck(t *testing.T) {
+	stub := newStubAckServer(t, false, true)
+	client := connectToStub(t, stub)
+
+	err := subscribeAndWait(context.Background(), client.Subscribe, []string{"BTCUSDT@index"}, 500*time.Millisecond, 3)
+	if err == nil {
+		t.Fatal("Expected subscribe to fail on an error ACK")
+	}
+
+	if ids := stub.requestIds(); len(ids) != 1 {
+		t.Errorf("Expected a single SUBSCRIBE request for an error ACK, got %d", len(ids))
+	}
+}
+
+// TestSubscribeAndWaitGivesUp verifies the attempt limit is honoured when no ACK ever arrives
+func TestSubscribeAndWaitGivesUp(t *testing.T) {
+	attempts := 0
+	neverAcks := func(ctx context.Context, streams []string) error {
+		attempts++
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	err := subscribeAndWait(context.Background(), neverAcks, []string{"BTCUSDT@index"}, 50*time.Millisecond, 3)
+	if err == nil {
+		t.Fatal("Expected subscribe to fail when no ACK arrives")
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
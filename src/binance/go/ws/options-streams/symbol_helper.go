@@ -116,6 +116,12 @@ func getActiveExpirationDates(underlying string) ([]string, error) {
 	return expirations, nil
 }
 
+// isUnderlyingLive reports whether REST exchange info lists any active contracts for the underlying
+func isUnderlyingLive(underlying string) bool {
+	symbols, err := getActiveOptionsSymbols(underlying)
+	return err == nil && len(symbols) > 0
+}
+
 // selectNearestExpirySymbol returns a symbol with the nearest expiration date
 func selectNearestExpirySymbol(underlying string, optionType string) (string, error) {
 	symbols, err := getActiveOptionsSymbols(underlying)
@@ -254,6 +260,23 @@ func validateSymbolFormat(symbol string) bool {
 	return true
 }
 
+// optionExpiryLayout is the YYMMDD expiry date embedded in option symbols
+const optionExpiryLayout = "060102"
+
+// parseOptionExpiry returns the expiry date (UTC midnight) embedded in an option symbol
+// such as BTC-250328-50000-C
+func parseOptionExpiry(symbol string) (time.Time, error) {
+	if !validateSymbolFormat(symbol) {
+		return time.Time{}, fmt.Errorf("invalid option symbol format: %s", symbol)
+	}
+
+	expiry, err := time.Parse(optionExpiryLayout, strings.Split(symbol, "-")[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry date in %s: %w", symbol, err)
+	}
+	return expiry, nil
+}
+
 // getCurrentUnderlyingPrice gets the current price of the underlying asset
 func getCurrentUnderlyingPrice(underlying string) (float64, error) {
 	// Setup REST client
@@ -292,6 +315,89 @@ func getCurrentUnderlyingPrice(underlying string) (float64, error) {
 	return price, nil
 }
 
+// getRESTOpenInterest returns open interest in contracts per symbol for an underlying/expiration
+func getRESTOpenInterest(underlying string, expiration string) (map[string]float64, error) {
+	// Setup REST client
+	cfg := openapi.NewConfiguration()
+	cfg.Servers = openapi.ServerConfigurations{
+		{
+			URL:         "https://eapi.binance.com",
+			Description: "Binance Options API (Production)",
+		},
+	}
+
+	// Override with custom server if provided
+	if serverURL := os.Getenv("BINANCE_OPTIONS_REST_SERVER"); serverURL != "" {
+		cfg.Servers[0].URL = serverURL
+	}
+
+	client := openapi.NewAPIClient(cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, _, err := client.OptionsAPI.GetOpenInterestV1(ctx).
+		UnderlyingAsset(underlying).
+		Expiration(expiration).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open interest for %s %s: %w", underlying, expiration, err)
+	}
+
+	openInterest := make(map[string]float64)
+	for _, item := range resp {
+		if item.Symbol == nil || item.SumOpenInterest == nil {
+			continue
+		}
+		value, err := strconv.ParseFloat(*item.SumOpenInterest, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse open interest %s for %s: %w", *item.SumOpenInterest, *item.Symbol, err)
+		}
+		openInterest[*item.Symbol] = value
+	}
+
+	return openInterest, nil
+}
+
+// getRESTMarkPrices returns the REST mark price per symbol for every option on an underlying
+func getRESTMarkPrices(underlying string) (map[string]float64, error) {
+	// Setup REST client
+	cfg := openapi.NewConfiguration()
+	cfg.Servers = openapi.ServerConfigurations{
+		{
+			URL:         "https://eapi.binance.com",
+			Description: "Binance Options API (Production)",
+		},
+	}
+
+	// Override with custom server if provided
+	if serverURL := os.Getenv("BINANCE_OPTIONS_REST_SERVER"); serverURL != "" {
+		cfg.Servers[0].URL = serverURL
+	}
+
+	client := openapi.NewAPIClient(cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, _, err := client.OptionsAPI.GetMarkV1(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mark prices: %w", err)
+	}
+
+	markPrices := make(map[string]float64)
+	for _, item := range resp {
+		if item.Symbol == nil || item.MarkPrice == nil || !strings.HasPrefix(*item.Symbol, underlying+"-") {
+			continue
+		}
+		value, err := strconv.ParseFloat(*item.MarkPrice, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mark price %s for %s: %w", *item.MarkPrice, *item.Symbol, err)
+		}
+		markPrices[*item.Symbol] = value
+	}
+
+	return markPrices, nil
+}
+
 // abs returns the absolute value of x
 func abs(x float64) float64 {
 	if x < 0 {
@@ -0,0 +1,42 @@
+package streamstest
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseOptionExpiry tests expiry parsing against known option symbol formats
+func TestParseOptionExpiry(t *testing.T) {
+	tests := []struct {
+		symbol  string
+		want    time.Time
+		wantErr bool
+	}{
+		{"BTC-250328-50000-C", time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), false},
+		{"ETH-241227-3500-P", time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC), false},
+		{"BNB-260102-600-C", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{"BTCUSDT", time.Time{}, true},
+		{"BTC-2503-50000-C", time.Time{}, true},
+		{"BTC-251332-50000-C", time.Time{}, true},
+		{"BTC-250328-50000-X", time.Time{}, true},
+		{"BTC-250328-strike-C", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			got, err := parseOptionExpiry(tt.symbol)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %s, got expiry %s", tt.symbol, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", tt.symbol, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Expiry for %s = %s, want %s", tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
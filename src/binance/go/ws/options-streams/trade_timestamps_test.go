@@ -0,0 +1,78 @@
+package streamstest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openxapi/binance-go/ws/options-streams/models"
+)
+
+const (
+	// tradeTimeSkewTolerance allows EventTime to trail TradeTime slightly for server clock quirks
+	tradeTimeSkewTolerance = 100 * time.Millisecond
+	// tradeEventMaxAge bounds how far from local time a live trade timestamp may be
+	tradeEventMaxAge = 5 * time.Minute
+)
+
+// tradeTimestampViolation describes why an event/trade timestamp pair is inconsistent,
+// or returns an empty string if EventTime >= TradeTime and both are recent
+func tradeTimestampViolation(eventTime, tradeTime int64, now time.Time) string {
+	if lag := time.Duration(tradeTime-eventTime) * time.Millisecond; lag > tradeTimeSkewTolerance {
+		return fmt.Sprintf("event time precedes trade time by %v", lag)
+	}
+	for _, ts := range []struct {
+		name  string
+		value int64
+	}{{"event time", eventTime}, {"trade time", tradeTime}} {
+		age := now.Sub(time.UnixMilli(ts.value))
+		if age > tradeEventMaxAge || age < -tradeEventMaxAge {
+			return fmt.Sprintf("%s is %v away from local time", ts.name, age.Round(time.Millisecond))
+		}
+	}
+	return ""
+}
+
+// validateTradeTimestamps checks EventTime against TradeTime for every trade event
+func validateTradeTimestamps(t *testing.T, events []interface{}) {
+	t.Helper()
+	now := time.Now()
+	for i, event := range events {
+		trade, ok := event.(*models.TradeEvent)
+		if !ok {
+			continue
+		}
+		if reason := tradeTimestampViolation(trade.EventTime, trade.TradeTime, now); reason != "" {
+			t.Errorf("trade %d (%s): %s (E=%d, T=%d)", i, trade.Symbol, reason, trade.EventTime, trade.TradeTime)
+		}
+	}
+}
+
+// TestTradeTimestampViolation tests the event/trade timestamp consistency rules
+func TestTradeTimestampViolation(t *testing.T) {
+	now := time.UnixMilli(1760000000000)
+	ms := now.UnixMilli()
+
+	tests := []struct {
+		name       string
+		eventTime  int64
+		tradeTime  int64
+		wantReason string
+	}{
+		{"EventAfterTrade", ms, ms - 5, ""},
+		{"WithinSkew", ms, ms + tradeTimeSkewTolerance.Milliseconds(), ""},
+		{"EventBeforeTrade", ms, ms + tradeTimeSkewTolerance.Milliseconds() + 1, "event time precedes trade time"},
+		{"Stale", ms - 2*tradeEventMaxAge.Milliseconds(), ms - 2*tradeEventMaxAge.Milliseconds(), "event time is"},
+		{"Future", ms + 2*tradeEventMaxAge.Milliseconds(), ms + 2*tradeEventMaxAge.Milliseconds(), "event time is"},
+	}
+	for _, tt := range tests {
+		reason := tradeTimestampViolation(tt.eventTime, tt.tradeTime, now)
+		if tt.wantReason == "" && reason != "" {
+			t.Errorf("%s: unexpected violation %q", tt.name, reason)
+		}
+		if tt.wantReason != "" && !strings.Contains(reason, tt.wantReason) {
+			t.Errorf("%s: violation %q, want it to mention %q", tt.name, reason, tt.wantReason)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package options_test
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/openxapi/integration-tests/src/binance/go/proxyenv"
+)
+
+// wsProxyEnvVar names the proxy used for all WebSocket traffic
+const wsProxyEnvVar = "BINANCE_TEST_WS_PROXY"
+
+// configureWSProxy routes WebSocket traffic through BINANCE_TEST_WS_PROXY when set. The SDK
+// dials with gorilla's default dialer, so the proxy is set on that dialer rather than exported
+// to the process environment.
+func configureWSProxy() error {
+	u, err := proxyenv.FromEnv(wsProxyEnvVar)
+	if err != nil || u == nil {
+		return err
+	}
+	websocket.DefaultDialer.Proxy = http.ProxyURL(u)
+	return nil
+}
+
+// TestWSProxyConfiguration tests that configureWSProxy points the websocket dialer at BINANCE_TEST_WS_PROXY
+func TestWSProxyConfiguration(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start proxy listener: %v", err)
+	}
+	defer listener.Close()
+
+	original := websocket.DefaultDialer.Proxy
+	t.Cleanup(func() { websocket.DefaultDialer.Proxy = original })
+
+	want := "http://" + listener.Addr().String()
+	t.Setenv(wsProxyEnvVar, want)
+	if err := configureWSProxy(); err != nil {
+		t.Fatalf("configureWSProxy() failed: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://stream.binance.com:9443/ws", nil)
+	got, err := websocket.DefaultDialer.Proxy(req)
+	if err != nil || got == nil || got.String() != want {
+		t.Errorf("Expected the dialer to use proxy %s, got %v (%v)", want, got, err)
+	}
+}
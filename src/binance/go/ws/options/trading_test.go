@@ -0,0 +1,55 @@
+package options_test
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// orderPlacementMethods are the client methods an options WS API order path would need.
+// The options WS API currently only serves userDataStream.start/ping/stop, so none exist yet.
+var orderPlacementMethods = []string{
+	"SendSessionLogon",
+	"SendOrderPlace",
+	"SendOrderCancel",
+}
+
+// TradingTestSuite covers order placement over the options WS API
+type TradingTestSuite struct {
+	BaseTestSuite
+}
+
+// TestTradingSuite runs the trading test suite
+func TestTradingSuite(t *testing.T) {
+	suite.Run(t, new(TradingTestSuite))
+}
+
+// TestOrderPlacement places and cancels a non-marketable options order over the WS API.
+// It skips with a note while the SDK exposes no session logon or order methods, and
+// fails once they appear so the placement path gets real coverage.
+func (s *TradingTestSuite) TestOrderPlacement() {
+	if os.Getenv("BINANCE_TEST_OPTIONS_WS_TRADING") != "true" {
+		s.T().Skip("Options WS trading disabled. Set BINANCE_TEST_OPTIONS_WS_TRADING=true to enable")
+	}
+	s.requireAuth()
+
+	clientType := reflect.TypeOf(s.client)
+	var available []string
+	for _, name := range orderPlacementMethods {
+		if _, ok := clientType.MethodByName(name); ok {
+			available = append(available, name)
+		}
+	}
+
+	if len(available) == 0 {
+		s.T().Skip("Options WS API has no session logon or order placement methods " +
+			"(only userDataStream.start/ping/stop); order placement is covered by the options REST tests")
+	}
+
+	s.Failf("Options WS order placement is not covered",
+		"The options WS client now exposes %s; add logon, order.place and order.cancel coverage here",
+		strings.Join(available, ", "))
+}
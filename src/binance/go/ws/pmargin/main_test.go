@@ -30,6 +30,11 @@ func init() {
 		testServerURL = "wss://fstream.binance.com/pm/ws/{listenKey}"
 	}
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		log.Fatalf("Invalid proxy configuration: %v", err)
+	}
+
 	// Configure logging for tests
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 }
@@ -2,8 +2,15 @@ package streamstest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/openxapi/binance-go/ws/spot-streams/models"
 )
 
 // TestErrorHandling tests error handling in stream operations
@@ -223,10 +230,12 @@ func TestEmptyStreamList(t *testing.T) {
 	}
 }
 
-// TestMaxStreamLimits tests behavior when approaching stream limits
-func TestMaxStreamLimits(t *testing.T) {
+// TestSubscribeTooManyParams sends one SUBSCRIBE carrying more streams than a connection may
+// hold, then verifies the rejection is surfaced and the connection is still usable. Growing
+// a connection towards the limit over several requests is covered by TestConnectionStreamLimit.
+func TestSubscribeTooManyParams(t *testing.T) {
 	if testing.Short() {
-		t.Skip("Skipping max stream limits test in short mode")
+		t.Skip("Skipping oversized subscribe test in short mode")
 	}
 
 	client := setupAndConnectClient(t)
@@ -234,52 +243,238 @@ func TestMaxStreamLimits(t *testing.T) {
 
 	ctx := context.Background()
 
-	// Generate a large number of streams (approaching Binance limits)
-	symbols := []string{"btcusdt", "ethusdt", "adausdt", "dotusdt", "linkusdt"}
-	streamTypes := []string{"trade", "depth5", "miniTicker", "bookTicker"}
-	
-	var streams []string
-	for _, symbol := range symbols {
-		for _, streamType := range streamTypes {
-			streams = append(streams, symbol+"@"+streamType)
+	streams := generateDistinctStreams(t, documentedStreamLimit+1)
+	if len(streams) <= documentedStreamLimit {
+		t.Fatalf("Could only generate %d distinct stream names, need %d", len(streams), documentedStreamLimit+1)
+	}
+
+	err := client.Subscribe(ctx, streams)
+
+	// Give the server time to respond with an error frame if it rejected the request
+	time.Sleep(3 * time.Second)
+	errorEvents := client.GetEventsByType("error")
+	if err == nil && len(errorEvents) == 0 {
+		if unsubErr := client.Unsubscribe(ctx, streams); unsubErr != nil {
+			t.Logf("Error unsubscribing oversized request: %v", unsubErr)
 		}
+		t.Fatalf("SUBSCRIBE with %d params was accepted; expected the %d stream limit to reject it",
+			len(streams), documentedStreamLimit)
 	}
+	t.Logf("SUBSCRIBE with %d params rejected: err=%v, error events=%d", len(streams), err, len(errorEvents))
 
-	// Add kline streams with different intervals
-	intervals := []string{"1m", "5m", "15m", "1h"}
-	for _, symbol := range symbols {
-		for _, interval := range intervals {
-			streams = append(streams, symbol+"@kline_"+interval)
+	// The oversized request must not wedge the client
+	if !client.IsConnected() {
+		t.Fatal("Client disconnected after oversized SUBSCRIBE request")
+	}
+
+	if err := client.Subscribe(ctx, []string{"btcusdt@trade"}); err != nil {
+		t.Fatalf("Failed to subscribe after oversized request: %v", err)
+	}
+
+	if err := client.WaitForEventsByType("trade", 1, 15*time.Second); err != nil {
+		t.Logf("⚠️  No trade events after oversized request (may be due to low market activity): %v", err)
+	} else {
+		t.Logf("✅ Connection still delivers events after oversized SUBSCRIBE")
+	}
+
+	if err := client.Unsubscribe(ctx, []string{"btcusdt@trade"}); err != nil {
+		t.Logf("Error unsubscribing: %v", err)
+	}
+}
+
+// Connection stream limit probe: grow in large batches up to the documented 1024, then one
+// stream at a time, and stop at connectionStreamProbeCap so a missing limit cannot run away
+const (
+	documentedStreamLimit    = 1024
+	connectionStreamBatch    = 128
+	connectionStreamProbeCap = documentedStreamLimit + 8
+	// subscribeInterval keeps the probe under the 5 incoming messages per second limit
+	subscribeInterval = 300 * time.Millisecond
+)
+
+// TestConnectionStreamLimit subscribes to more and more streams on one connection until the
+// server rejects a subscription, then verifies the error is surfaced and the connection still works
+func TestConnectionStreamLimit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping connection stream limit discovery test in short mode")
+	}
+
+	client := setupAndConnectClient(t)
+	defer client.Disconnect()
+
+	ctx := context.Background()
+
+	streams := generateDistinctStreams(t, connectionStreamProbeCap)
+	if len(streams) < connectionStreamProbeCap {
+		t.Fatalf("Could only generate %d distinct stream names, need %d", len(streams), connectionStreamProbeCap)
+	}
+
+	subscribed := 0
+	defer func() {
+		// Unsubscribe in batches under the message rate limit
+		for start := 0; start < subscribed && client.IsConnected(); start += connectionStreamBatch {
+			end := start + connectionStreamBatch
+			if end > subscribed {
+				end = subscribed
+			}
+			if err := client.Unsubscribe(ctx, streams[start:end]); err != nil {
+				t.Logf("Error unsubscribing streams %d-%d: %v", start, end, err)
+			}
+			time.Sleep(subscribeInterval)
 		}
+	}()
+
+	var rejectErr error
+	for subscribed < len(streams) {
+		next := subscribed + connectionStreamBatch
+		if subscribed >= documentedStreamLimit {
+			next = subscribed + 1
+		}
+		if next > len(streams) {
+			next = len(streams)
+		}
+
+		errorsBefore := len(client.GetEventsByType("error"))
+		err := client.Subscribe(ctx, streams[subscribed:next])
+		time.Sleep(subscribeInterval)
+		if errorEvents := client.GetEventsByType("error"); err == nil && len(errorEvents) > errorsBefore {
+			err = fmt.Errorf("error event: %+v", errorEvents[len(errorEvents)-1])
+		}
+		if err != nil {
+			rejectErr = err
+			break
+		}
+		subscribed = next
 	}
 
-	t.Logf("Attempting to subscribe to %d streams", len(streams))
+	if rejectErr == nil {
+		t.Skipf("Server accepted all %d streams on one connection - no limit observed below the probe cap", subscribed)
+		return
+	}
 
-	// Subscribe to all streams
-	err := client.Subscribe(ctx, streams)
-	if err != nil {
-		t.Logf("Error subscribing to many streams: %v", err)
+	t.Logf("Discovered per-connection stream limit: %d streams accepted, stream %d rejected: %v",
+		subscribed, subscribed+1, rejectErr)
+	if subscribed == 0 {
+		t.Fatalf("The first subscription was rejected, so no limit was probed: %v", rejectErr)
+	}
+	if strings.TrimSpace(rejectErr.Error()) == "" {
+		t.Error("Limit rejection surfaced with an empty error message")
 	}
 
-	// Wait for server response
-	time.Sleep(5 * time.Second)
+	// At the limit the connection must still be open and deliver events for its streams
+	if !client.IsConnected() {
+		t.Fatal("Client disconnected when the per-connection stream limit was reached")
+	}
 
-	// Check for error events
-	errorEvents := client.GetEventsByType("error")
-	if len(errorEvents) > 0 {
-		t.Logf("Received %d error events for many streams", len(errorEvents))
+	client.ClearEvents()
+	if err := client.ListSubscriptions(ctx); err != nil {
+		t.Fatalf("LIST_SUBSCRIPTIONS failed at the stream limit: %v", err)
 	}
 
-	// Check how many streams are actually active
-	activeStreams := client.GetActiveStreams()
-	t.Logf("Successfully subscribed to %d out of %d streams", len(activeStreams), len(streams))
+	// Either a LIST result or a trade event shows the connection still answers at the limit
+	var listed []string
+	listedOK := false
+	deadline := time.Now().Add(15 * time.Second)
+	for time.Now().Before(deadline) {
+		if listed, listedOK = listedStreams(client.GetEventsByType("subscriptionResponse")); listedOK {
+			break
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	traded := len(client.GetEventsByType("trade")) > 0
+
+	switch {
+	case listedOK:
+		if len(listed) != subscribed {
+			t.Errorf("LIST_SUBSCRIPTIONS returned %d streams at the limit, expected %d", len(listed), subscribed)
+		} else {
+			t.Logf("✅ LIST_SUBSCRIPTIONS reports all %d subscribed streams", subscribed)
+		}
+	case traded:
+		t.Logf("⚠️  No LIST_SUBSCRIPTIONS result, but the connection still delivers trade events with %d streams", subscribed)
+	default:
+		t.Errorf("Neither a LIST_SUBSCRIPTIONS result nor a trade event arrived with %d streams subscribed", subscribed)
+	}
+}
 
-	// Cleanup
-	if len(activeStreams) > 0 {
-		if err := client.Unsubscribe(ctx, activeStreams); err != nil {
-			t.Logf("Error unsubscribing from active streams: %v", err)
+// listedStreams returns the stream names from the most recent LIST_SUBSCRIPTIONS response,
+// recognised as the only subscription response whose result is an array rather than null
+func listedStreams(events []interface{}) ([]string, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		response, ok := events[i].(*models.SubscriptionResponse)
+		if !ok {
+			continue
+		}
+		result, ok := response.AlwaysNullForSuccessfulSubscription.([]interface{})
+		if !ok {
+			continue
+		}
+		streams := make([]string, 0, len(result))
+		for _, entry := range result {
+			if name, ok := entry.(string); ok {
+				streams = append(streams, name)
+			}
+		}
+		return streams, true
+	}
+	return nil, false
+}
+
+// exchangeInfoURL lists the symbols served by the testnet streams these tests connect to
+const exchangeInfoURL = "https://testnet.binance.vision/api/v3/exchangeInfo"
+
+// lightStreamTypes each push at most about once a second, unlike trade, bookTicker and depth,
+// so holding a connection's worth of them stays cheap for both sides
+var lightStreamTypes = []string{
+	"miniTicker", "avgPrice", "ticker_1h", "ticker_4h", "ticker_1d",
+	"kline_1m", "kline_3m", "kline_5m", "kline_15m", "kline_30m", "kline_1h", "kline_2h",
+	"kline_4h", "kline_6h", "kline_8h", "kline_12h", "kline_1d", "kline_3d", "kline_1w", "kline_1M",
+}
+
+// listedSymbols returns the lowercase symbols exchangeInfo currently lists as TRADING, fetched
+// through the same proxy as the WebSocket connections
+func listedSymbols(t *testing.T) []string {
+	t.Helper()
+	httpClient := &http.Client{
+		Timeout:   15 * time.Second,
+		Transport: &http.Transport{Proxy: websocket.DefaultDialer.Proxy},
+	}
+	resp, err := httpClient.Get(exchangeInfoURL)
+	if err != nil {
+		t.Fatalf("Failed to fetch exchangeInfo: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var info struct {
+		Symbols []struct {
+			Symbol string `json:"symbol"`
+			Status string `json:"status"`
+		} `json:"symbols"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		t.Fatalf("Failed to decode exchangeInfo: %v", err)
+	}
+	symbols := make([]string, 0, len(info.Symbols))
+	for _, s := range info.Symbols {
+		if s.Status == "TRADING" {
+			symbols = append(symbols, strings.ToLower(s.Symbol))
+		}
+	}
+	return symbols
+}
+
+// generateDistinctStreams builds up to n distinct lightweight streams on currently listed symbols
+func generateDistinctStreams(t *testing.T, n int) []string {
+	streams := make([]string, 0, n)
+	for _, symbol := range listedSymbols(t) {
+		for _, streamType := range lightStreamTypes {
+			if len(streams) == n {
+				return streams
+			}
+			streams = append(streams, fmt.Sprintf("%s@%s", symbol, streamType))
 		}
 	}
+	return streams
 }
 
 // TestReconnectionAfterError tests behavior after connection errors
@@ -340,6 +535,57 @@ func TestReconnectionAfterError(t *testing.T) {
 	}
 }
 
+// TestHandlersSurviveReconnect tests that handlers registered before a disconnect keep firing
+// after reconnecting, without calling SetupEventHandlers again. Long-running consumers rely on
+// the client retaining registrations across connection cycles; if the SDK drops them on
+// disconnect this fails so the behavior is explicit.
+func TestHandlersSurviveReconnect(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping reconnection test in short mode")
+	}
+
+	// Handlers are registered exactly once, here
+	client := setupAndConnectClient(t)
+	defer client.Disconnect()
+
+	ctx := context.Background()
+
+	stream := "btcusdt@trade"
+	if err := client.Subscribe(ctx, []string{stream}); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	if err := client.WaitForEventsByType("trade", 1, 15*time.Second); err != nil {
+		t.Fatalf("Handlers not invoked before reconnect: %v", err)
+	}
+	t.Logf("Received %d trade events before disconnect", len(client.GetEventsByType("trade")))
+
+	if err := client.Disconnect(); err != nil {
+		t.Fatalf("Failed to disconnect: %v", err)
+	}
+	client.ClearEvents()
+
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := client.Connect(connectCtx); err != nil {
+		t.Fatalf("Failed to reconnect: %v", err)
+	}
+
+	// A new connection starts without subscriptions, so subscribe again but do not re-register handlers
+	if err := client.Subscribe(ctx, []string{stream}); err != nil {
+		t.Fatalf("Failed to subscribe after reconnection: %v", err)
+	}
+
+	if err := client.WaitForEventsByType("trade", 1, 15*time.Second); err != nil {
+		t.Errorf("Handlers registered before the disconnect were not invoked after reconnect: %v. "+
+			"The client appears to clear handler registrations on disconnect; consumers must call the Handle* methods again", err)
+	}
+
+	// Let a few more events through so the count reflects steady-state delivery
+	time.Sleep(3 * time.Second)
+	t.Logf("Post-reconnect handler invocations: trade=%d, total=%d",
+		len(client.GetEventsByType("trade")), len(client.GetEventsReceived()))
+}
+
 // TestConcurrentSubscriptions tests concurrent subscription operations
 func TestConcurrentSubscriptions(t *testing.T) {
 	if testing.Short() {
@@ -4,9 +4,14 @@ go 1.24.1
 
 replace github.com/openxapi/binance-go/ws => ../../../../../../binance-go/ws
 
-require github.com/openxapi/binance-go/ws v0.0.0-00010101000000-000000000000
+replace github.com/openxapi/integration-tests/src/binance/go/proxyenv => ../../proxyenv
+
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/openxapi/binance-go/ws v0.0.0-00010101000000-000000000000
+	github.com/openxapi/integration-tests/src/binance/go/proxyenv v0.0.0
+)
 
 require (
 	github.com/google/uuid v1.6.0 // indirect
-	github.com/gorilla/websocket v1.5.3 // indirect
 )
@@ -13,6 +13,12 @@ import (
 func TestMain(m *testing.M) {
 	flag.Parse()
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run the tests
 	code := m.Run()
 
@@ -146,8 +152,10 @@ func TestFullIntegrationSuite(t *testing.T) {
 		{"ConnectionErrors", TestConnectionErrors, true},
 		{"UnsubscribeNonExistentStream", TestUnsubscribeNonExistentStream, true},
 		{"EmptyStreamList", TestEmptyStreamList, true},
-		{"MaxStreamLimits", TestMaxStreamLimits, false},
+		{"SubscribeTooManyParams", TestSubscribeTooManyParams, false},
+		{"ConnectionStreamLimit", TestConnectionStreamLimit, false},
 		{"ReconnectionAfterError", TestReconnectionAfterError, false},
+		{"HandlersSurviveReconnect", TestHandlersSurviveReconnect, false},
 		{"ConcurrentSubscriptionsError", TestConcurrentSubscriptions, false},
 
 		// Combined streams tests
@@ -0,0 +1,154 @@
+package wstest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	spotws "github.com/openxapi/binance-go/ws/spot"
+	"github.com/openxapi/binance-go/ws/spot/models"
+)
+
+const (
+	// disconnectGuard bounds every step after Disconnect; exceeding it means a goroutine is stuck
+	disconnectGuard = 5 * time.Second
+	// disconnectAttempts repeats the race so at least one Disconnect lands while a request is pending
+	disconnectAttempts = 5
+	// inFlightDepthLimit requests the largest book so the response is slow to arrive
+	inFlightDepthLimit = 5000
+)
+
+// inFlightOutcome records how one request resolved while Disconnect ran concurrently
+type inFlightOutcome struct {
+	sendErr     error
+	callbackErr error
+	answered    bool
+}
+
+// raceDisconnect sends a depth request and calls Disconnect from another goroutine without
+// waiting for the response, failing t if either the request or Disconnect does not return in time
+func raceDisconnect(t *testing.T, client *spotws.Client) inFlightOutcome {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*disconnectGuard)
+	defer cancel()
+
+	callbackChan := make(chan error, 1)
+	sendChan := make(chan error, 1)
+	go func() {
+		sendChan <- client.SendDepth(ctx,
+			models.NewDepthRequest().
+				SetId(newRequestID("depth")).
+				SetSymbol("BTCUSDT").
+				SetLimit(inFlightDepthLimit),
+			func(response *models.DepthResponse, err error) error {
+				callbackChan <- err
+				return err
+			})
+	}()
+
+	disconnectChan := make(chan error, 1)
+	go func() {
+		disconnectChan <- client.Disconnect()
+	}()
+
+	guard := time.After(disconnectGuard)
+	var outcome inFlightOutcome
+	select {
+	case err := <-disconnectChan:
+		if err != nil {
+			t.Logf("Disconnect returned: %v", err)
+		}
+	case <-guard:
+		t.Fatalf("Disconnect did not return within %v while a request was in flight; likely deadlocked", disconnectGuard)
+	}
+
+	select {
+	case outcome.sendErr = <-sendChan:
+	case <-guard:
+		t.Fatalf("SendDepth did not return within %v of Disconnect; likely deadlocked", disconnectGuard)
+	}
+	if outcome.sendErr != nil {
+		return outcome
+	}
+
+	// The request went out, so its callback must resolve: with the response if it beat the
+	// disconnect, otherwise with an error rather than waiting forever on a closed socket
+	select {
+	case outcome.callbackErr = <-callbackChan:
+		outcome.answered = outcome.callbackErr == nil
+	case <-guard:
+		t.Fatalf("Pending depth request was never resolved within %v of Disconnect; its response correlation is leaked", disconnectGuard)
+	}
+	return outcome
+}
+
+// TestDisconnectDuringInFlightRequest calls Disconnect while a WS API request is pending and
+// checks that nothing hangs, the pending request resolves, and the client is left cleanly
+// disconnected. Run with -race to catch unsynchronized access during shutdown.
+func TestDisconnectDuringInFlightRequest(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			var raced, answered int
+			for attempt := 1; attempt <= disconnectAttempts; attempt++ {
+				testSuite.rateLimit.Wait()
+				client, err := setupClient(config)
+				if err != nil {
+					t.Fatalf("Failed to setup client: %v", err)
+				}
+
+				outcome := raceDisconnect(t, client)
+				switch {
+				case outcome.sendErr != nil:
+					raced++
+					t.Logf("Attempt %d: send failed after Disconnect: %v", attempt, outcome.sendErr)
+				case outcome.answered:
+					answered++
+					t.Logf("Attempt %d: response arrived before Disconnect took effect", attempt)
+				default:
+					raced++
+					t.Logf("Attempt %d: pending request resolved with: %v", attempt, outcome.callbackErr)
+				}
+
+				// The client must now refuse new requests promptly instead of queueing them
+				pingErr := make(chan error, 1)
+				go func() {
+					ctx, cancel := context.WithTimeout(context.Background(), disconnectGuard)
+					defer cancel()
+					pingErr <- client.SendPing(ctx, models.NewPingRequest(),
+						func(response *models.PingResponse, err error) error {
+							return err
+						})
+				}()
+				select {
+				case err := <-pingErr:
+					if err == nil {
+						t.Errorf("Attempt %d: client still accepted a request after Disconnect", attempt)
+					}
+				case <-time.After(disconnectGuard):
+					t.Fatalf("Attempt %d: SendPing on a disconnected client did not return within %v", attempt, disconnectGuard)
+				}
+
+				// A second Disconnect on an already closed client must also return
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					client.Disconnect()
+				}()
+				select {
+				case <-done:
+				case <-time.After(disconnectGuard):
+					t.Fatalf("Attempt %d: repeated Disconnect did not return within %v", attempt, disconnectGuard)
+				}
+			}
+
+			if raced == 0 {
+				t.Logf("⚠️ Every response beat Disconnect in %d attempts; the in-flight path was not exercised", disconnectAttempts)
+			}
+			t.Logf("Disconnect during in-flight request: %d raced, %d answered first, no hangs", raced, answered)
+		})
+	}
+}
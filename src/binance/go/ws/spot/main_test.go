@@ -15,6 +15,12 @@ import (
 func TestMain(m *testing.M) {
 	flag.Parse()
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run the tests
 	code := m.Run()
 
@@ -124,6 +130,8 @@ func TestFullIntegrationSuite(t *testing.T) {
 			{"BookTicker", testBookTicker, AuthTypeNONE, KeyTypeHMAC},
 			{"TradingDay", testTradingDay, AuthTypeNONE, KeyTypeHMAC},
 			{"Depth", testDepth, AuthTypeNONE, KeyTypeHMAC},
+			{"DepthTransportParity", testDepthTransportParity, AuthTypeNONE, KeyTypeHMAC},
+			{"ServerTimeUnit", testServerTimeUnit, AuthTypeNONE, KeyTypeHMAC},
 			{"AvgPrice", testAvgPrice, AuthTypeNONE, KeyTypeHMAC},
 			{"TradesAggregate", testTradesAggregate, AuthTypeNONE, KeyTypeHMAC},
 			{"TradesHistorical", testTradesHistorical, AuthTypeNONE, KeyTypeHMAC},
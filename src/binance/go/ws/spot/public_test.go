@@ -2,13 +2,34 @@ package wstest
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	spotws "github.com/openxapi/binance-go/ws/spot"
 	"github.com/openxapi/binance-go/ws/spot/models"
 )
 
+const (
+	// spotTestnetRESTURL is the REST counterpart of the testnet WS API
+	spotTestnetRESTURL = "https://testnet.binance.vision"
+	// depthParityTolerance is the allowed relative top-of-book drift between WS and REST snapshots
+	depthParityTolerance = 0.005
+	// timeUnitSkew is the allowed drift between microsecond and millisecond server times beyond the call window
+	timeUnitSkew = time.Second
+)
+
+// newRequestID returns a unique request id so responses can be correlated with their request
+func newRequestID(method string) string {
+	return fmt.Sprintf("%s-%d", method, time.Now().UnixNano())
+}
+
 func TestPing(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeNONE {
@@ -130,6 +151,28 @@ func TestDepth(t *testing.T) {
 	}
 }
 
+func TestDepthTransportParity(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue // Skip non-public configs - depth is a public endpoint
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "DepthTransportParity", testDepthTransportParity)
+		})
+	}
+}
+
+func TestServerTimeUnit(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.AuthType != AuthTypeNONE {
+			continue // Skip non-public configs - server time is a public endpoint
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testEndpoint(t, config, "ServerTimeUnit", testServerTimeUnit)
+		})
+	}
+}
+
 func TestAvgPrice(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeNONE {
@@ -212,16 +255,117 @@ func testServerTime(client *spotws.Client, config TestConfig) error {
 	}
 }
 
+// errTimeUnitUnsupported reports that the server rejected the timeUnit handshake parameter
+var errTimeUnitUnsupported = errors.New("timeUnit=MICROSECOND not supported")
+
+// setupMicrosecondClient connects a public client to the testnet WS API with timeUnit=MICROSECOND
+func setupMicrosecondClient() (*spotws.Client, error) {
+	client := spotws.NewClient()
+	if err := client.SetActiveServer("testnet1"); err != nil {
+		return nil, fmt.Errorf("failed to set testnet server: %w", err)
+	}
+	testnet := client.GetActiveServer()
+	if testnet == nil {
+		return nil, fmt.Errorf("testnet server not found")
+	}
+
+	if err := client.AddOrUpdateServer("testnet1-microsecond", testnet.URL+"?timeUnit=MICROSECOND",
+		"Binance Testnet (microsecond)", "Testnet WS API reporting times in microseconds"); err != nil {
+		return nil, fmt.Errorf("failed to add microsecond server: %w", err)
+	}
+	if err := client.SetActiveServer("testnet1-microsecond"); err != nil {
+		return nil, fmt.Errorf("failed to set microsecond server: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Probe the handshake directly so a rejected timeUnit is told apart by its HTTP status
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, testnet.URL+"?timeUnit=MICROSECOND", nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return nil, fmt.Errorf("%w: handshake returned HTTP %d", errTimeUnitUnsupported, resp.StatusCode)
+		}
+		return nil, fmt.Errorf("failed to probe microsecond handshake: %w", err)
+	}
+	conn.Close()
+
+	if err := client.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to WebSocket: %w", err)
+	}
+	return client, nil
+}
+
+// fetchServerTime returns the server time in the unit the connection was opened with
+func fetchServerTime(client *spotws.Client) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responseChan := make(chan int64, 1)
+	errChan := make(chan error, 1)
+
+	err := client.SendTime(ctx, models.NewTimeRequest().SetId(newRequestID("time")),
+		func(response *models.TimeResponse, err error) error {
+			if err != nil {
+				errChan <- err
+				return err
+			}
+			if response == nil || response.Result == nil {
+				errChan <- fmt.Errorf("time response has no result")
+				return nil
+			}
+			responseChan <- response.Result.ServerTime
+			return nil
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case serverTime := <-responseChan:
+		return serverTime, nil
+	case err := <-errChan:
+		return 0, err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
 func testExchangeInfo(client *spotws.Client, config TestConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	responseChan := make(chan error, 1)
+	requestID := newRequestID("exchangeInfo")
 
-	err := client.SendExchangeInfo(ctx, models.NewExchangeInfoRequest(),
+	err := client.SendExchangeInfo(ctx, models.NewExchangeInfoRequest().SetId(requestID),
 		func(response *models.ExchangeInfoResponse, err error) error {
-			responseChan <- err
-			return err
+			if err != nil {
+				responseChan <- err
+				return err
+			}
+
+			if response == nil || response.Result == nil {
+				responseChan <- fmt.Errorf("exchange info response has no result")
+				return nil
+			}
+			if response.Id != requestID {
+				responseChan <- fmt.Errorf("exchange info response id %q does not match request id %q", response.Id, requestID)
+				return nil
+			}
+			if len(response.Result.Symbols) == 0 {
+				responseChan <- fmt.Errorf("no symbols in exchange info response")
+				return nil
+			}
+			for _, limit := range response.RateLimits {
+				if limit.Limit > 0 && limit.Count > limit.Limit {
+					responseChan <- fmt.Errorf("rate limit %s exceeded: %d/%d", limit.RateLimitType, limit.Count, limit.Limit)
+					return nil
+				}
+			}
+
+			responseChan <- nil
+			return nil
 		})
 
 	if err != nil {
@@ -418,30 +562,184 @@ func testTradingDay(client *spotws.Client, config TestConfig) error {
 }
 
 func testDepth(client *spotws.Client, config TestConfig) error {
+	_, _, err := fetchWSDepthTop(client, "BTCUSDT", 100)
+	return err
+}
+
+// fetchWSDepthTop requests the order book over the WS API and returns the best bid and ask
+func fetchWSDepthTop(client *spotws.Client, symbol string, limit int) (float64, float64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	responseChan := make(chan error, 1)
+	type depthTop struct {
+		bid, ask float64
+		err      error
+	}
+	responseChan := make(chan depthTop, 1)
+	requestID := newRequestID("depth")
 
 	err := client.SendDepth(ctx,
 		models.NewDepthRequest().
-			SetSymbol("BTCUSDT").
-			SetLimit(100),
+			SetId(requestID).
+			SetSymbol(symbol).
+			SetLimit(limit),
 		func(response *models.DepthResponse, err error) error {
-			responseChan <- err
-			return err
+			if err != nil {
+				responseChan <- depthTop{err: err}
+				return err
+			}
+
+			if response == nil || response.Result == nil {
+				responseChan <- depthTop{err: fmt.Errorf("depth response has no result")}
+				return nil
+			}
+			if response.Id != requestID {
+				responseChan <- depthTop{err: fmt.Errorf("depth response id %q does not match request id %q", response.Id, requestID)}
+				return nil
+			}
+			for _, limit := range response.RateLimits {
+				if limit.Limit > 0 && limit.Count > limit.Limit {
+					responseChan <- depthTop{err: fmt.Errorf("rate limit %s exceeded: %d/%d", limit.RateLimitType, limit.Count, limit.Limit)}
+					return nil
+				}
+			}
+
+			bid, ask, err := bookTop(response.Result.Bids, response.Result.Asks)
+			responseChan <- depthTop{bid: bid, ask: ask, err: err}
+			return nil
 		})
 
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
 	select {
-	case err := <-responseChan:
-		return err
+	case top := <-responseChan:
+		return top.bid, top.ask, top.err
 	case <-ctx.Done():
-		return ctx.Err()
+		return 0, 0, ctx.Err()
+	}
+}
+
+// fetchRESTDepthTop requests the order book from the spot testnet REST API and returns the best bid and ask
+func fetchRESTDepthTop(symbol string, limit int) (float64, float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/api/v3/depth?symbol=%s&limit=%d", spotTestnetRESTURL, symbol, limit)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("REST depth request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("REST depth returned status %d", resp.StatusCode)
+	}
+
+	var book struct {
+		Bids [][]string `json:"bids"`
+		Asks [][]string `json:"asks"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&book); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode REST depth: %w", err)
+	}
+
+	return bookTop(book.Bids, book.Asks)
+}
+
+// bookTop validates depth levels and returns the best bid and ask, requiring bid < ask
+func bookTop(bids, asks [][]string) (float64, float64, error) {
+	if len(bids) == 0 {
+		return 0, 0, fmt.Errorf("no bids in depth response")
+	}
+	if len(asks) == 0 {
+		return 0, 0, fmt.Errorf("no asks in depth response")
+	}
+	for i, level := range append(append([][]string{}, bids...), asks...) {
+		if len(level) < 2 {
+			return 0, 0, fmt.Errorf("depth level at index %d has insufficient data", i)
+		}
+	}
+
+	bid, err := strconv.ParseFloat(bids[0][0], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid best bid %q: %w", bids[0][0], err)
+	}
+	ask, err := strconv.ParseFloat(asks[0][0], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid best ask %q: %w", asks[0][0], err)
+	}
+	if bid >= ask {
+		return 0, 0, fmt.Errorf("crossed book: best bid %v >= best ask %v", bid, ask)
+	}
+
+	return bid, ask, nil
+}
+
+// testDepthTransportParity compares the WS API top of book with the REST API top of book
+func testDepthTransportParity(client *spotws.Client, config TestConfig) error {
+	const symbol = "BTCUSDT"
+
+	wsBid, wsAsk, err := fetchWSDepthTop(client, symbol, 5)
+	if err != nil {
+		return fmt.Errorf("WS depth: %w", err)
+	}
+	restBid, restAsk, err := fetchRESTDepthTop(symbol, 5)
+	if err != nil {
+		return fmt.Errorf("REST depth: %w", err)
+	}
+
+	// The two snapshots are taken moments apart, so allow for book movement in between
+	if diff := math.Abs(wsBid-restBid) / restBid; diff > depthParityTolerance {
+		return fmt.Errorf("best bid differs between WS (%v) and REST (%v) by %.4f%%", wsBid, restBid, diff*100)
+	}
+	if diff := math.Abs(wsAsk-restAsk) / restAsk; diff > depthParityTolerance {
+		return fmt.Errorf("best ask differs between WS (%v) and REST (%v) by %.4f%%", wsAsk, restAsk, diff*100)
+	}
+
+	return nil
+}
+
+// testServerTimeUnit tests that a timeUnit=MICROSECOND connection reports server time ~1000x the millisecond one
+func testServerTimeUnit(client *spotws.Client, config TestConfig) error {
+	usClient, err := setupMicrosecondClient()
+	if errors.Is(err, errTimeUnitUnsupported) {
+		// The server refused the parameter itself, so there is nothing to compare;
+		// return nil (success) since this is not actually a failure
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to setup microsecond client: %w", err)
+	}
+	defer usClient.Disconnect()
+
+	// Bracket the microsecond reading between two millisecond readings
+	before, err := fetchServerTime(client)
+	if err != nil {
+		return fmt.Errorf("millisecond server time: %w", err)
+	}
+	micros, err := fetchServerTime(usClient)
+	if err != nil {
+		return fmt.Errorf("microsecond server time: %w", err)
+	}
+	after, err := fetchServerTime(client)
+	if err != nil {
+		return fmt.Errorf("millisecond server time: %w", err)
+	}
+
+	low := before*1000 - timeUnitSkew.Microseconds()
+	high := after*1000 + timeUnitSkew.Microseconds()
+	if micros < low || micros > high {
+		return fmt.Errorf("microsecond server time %d is not ~1000x the millisecond times [%d, %d]; timeUnit was not honored",
+			micros, before, after)
 	}
+	return nil
 }
 
 func testAvgPrice(client *spotws.Client, config TestConfig) error {
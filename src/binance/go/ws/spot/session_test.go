@@ -3,6 +3,10 @@ package wstest
 import (
 	"context"
 	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -43,6 +47,17 @@ func TestSessionLogout(t *testing.T) {
 	}
 }
 
+func TestSessionRelogon(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.KeyType != KeyTypeED25519 || config.AuthType != AuthTypeUSER_DATA {
+			continue // session.logon requires Ed25519 keys
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testSessionRelogonCycle(t, config)
+		})
+	}
+}
+
 func TestUserDataStreamStart(t *testing.T) {
 	for _, config := range getTestConfigs() {
 		if config.AuthType != AuthTypeTRADE {
@@ -109,6 +124,22 @@ func TestUserDataEventHandlers(t *testing.T) {
 	}
 }
 
+// TestUserDataBalanceEvent places and cancels a resting order, which is the only safe
+// balance-changing operation on testnet, and validates the outboundAccountPosition it produces
+func TestUserDataBalanceEvent(t *testing.T) {
+	if os.Getenv(wsTradingEnvVar) != "true" {
+		t.Skipf("User data balance event test disabled. Set %s=true to enable", wsTradingEnvVar)
+	}
+	for _, config := range getTestConfigs() {
+		if config.KeyType != KeyTypeED25519 || config.AuthType != AuthTypeTRADE {
+			continue // userDataStream.subscribe requires an Ed25519 session
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testUserDataBalanceEvent(t, config)
+		})
+	}
+}
+
 // Implementation functions
 func testSessionLogon(client *spotws.Client, config TestConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -264,6 +295,97 @@ func testSessionLogout(client *spotws.Client, config TestConfig) error {
 	}
 }
 
+// testSessionRelogonCycle verifies that an authenticated request relies on the
+// session after logon, fails once the session is logged out, and works again
+// after a second logon on the same connection.
+func testSessionRelogonCycle(t *testing.T, config TestConfig) {
+	testSuite.rateLimit.Wait()
+
+	// Connect without client-side credentials so that authenticated requests
+	// are only accepted through the session established by session.logon
+	sessionConfig := config
+	sessionConfig.APIKey = ""
+	sessionConfig.SecretKey = ""
+	sessionConfig.PrivateKey = ""
+
+	client, err := setupClient(sessionConfig)
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+	defer client.Disconnect()
+	defer func() {
+		if err := sendSessionLogout(client); err != nil {
+			t.Logf("Cleanup session logout failed: %v", err)
+		}
+	}()
+
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Initial session logon failed: %v", err)
+	}
+	if err := testAccount(client, config); err != nil {
+		t.Fatalf("Authenticated request after logon failed: %v", err)
+	}
+
+	if err := sendSessionLogout(client); err != nil {
+		t.Fatalf("Session logout failed: %v", err)
+	}
+	err = testAccount(client, config)
+	if err == nil {
+		t.Fatal("Expected authenticated request to fail after session logout")
+	}
+	if !isAuthError(err) {
+		t.Fatalf("Expected auth error after session logout, got: %v", err)
+	}
+	t.Logf("Authenticated request rejected after logout as expected: %v", err)
+
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Session re-logon failed: %v", err)
+	}
+	if err := testAccount(client, config); err != nil {
+		t.Fatalf("Authenticated request after re-logon failed: %v", err)
+	}
+}
+
+// sendSessionLogout forgets the API key associated with the connection
+func sendSessionLogout(client *spotws.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responseChan := make(chan error, 1)
+
+	err := client.SendSessionLogout(ctx, models.NewSessionLogoutRequest(),
+		func(response *models.SessionLogoutResponse, err error) error {
+			responseChan <- err
+			return err
+		})
+
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-responseChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isAuthError reports whether err looks like a rejection for missing or
+// invalid credentials rather than a transport or timeout failure
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{"-1022", "-2014", "-2015", "-1102", "apikey", "api-key", "signature", "unauthorized", "authenticat"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
 func testUserDataStreamStart(client *spotws.Client, config TestConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -657,3 +779,196 @@ func testUserDataEventHandlers(client *spotws.Client, config TestConfig) error {
 
 	return nil
 }
+
+// balanceEventWait bounds how long an order's outboundAccountPosition may take to arrive
+const balanceEventWait = 10 * time.Second
+
+func testUserDataBalanceEvent(t *testing.T, config TestConfig) {
+	testSuite.rateLimit.Wait()
+
+	client, err := setupClient(config)
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+	defer client.Disconnect()
+
+	positions := make(chan *models.OutboundAccountPositionEvent, 16)
+	client.HandleOutboundAccountPositionEvent(func(event *models.OutboundAccountPositionEvent) error {
+		positions <- event
+		return nil
+	})
+
+	// Events arrive on this logged-on session through userDataStream.subscribe, which needs no
+	// listen key; the listen key lifecycle is covered by the userDataStream.start/ping/stop tests
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Session logon failed: %v", err)
+	}
+	if err := subscribeUserDataStream(client); err != nil {
+		t.Fatalf("userDataStream.subscribe failed: %v", err)
+	}
+
+	currentPrice, err := getCurrentPrice(client, "BTCUSDT")
+	if err != nil {
+		t.Fatalf("Failed to get ticker price: %v", err)
+	}
+	// Far below market so the BUY rests and only locks quote balance
+	price := math.Floor(currentPrice*0.8*100) / 100
+	const quantity = 0.001
+	expectedLock := price * quantity
+
+	orderID, err := placeRestingOrder(client, fmt.Sprintf("%.2f", price), "0.001")
+	if err != nil {
+		t.Fatalf("Failed to place resting order: %v", err)
+	}
+	canceled := false
+	defer func() {
+		if !canceled {
+			if err := cancelRestingOrder(client, orderID); err != nil {
+				t.Logf("Cleanup cancel of order %d failed: %v", orderID, err)
+			}
+		}
+	}()
+
+	placedLocked, err := awaitAssetLocked(positions, "USDT")
+	if err != nil {
+		t.Fatalf("No outboundAccountPosition after placing order %d: %v", orderID, err)
+	}
+
+	if err := cancelRestingOrder(client, orderID); err != nil {
+		t.Fatalf("Failed to cancel order %d: %v", orderID, err)
+	}
+	canceled = true
+
+	canceledLocked, err := awaitAssetLocked(positions, "USDT")
+	if err != nil {
+		t.Fatalf("No outboundAccountPosition after canceling order %d: %v", orderID, err)
+	}
+
+	// Other activity on the account may move locked USDT too, so allow 1% slack on the delta
+	delta := placedLocked - canceledLocked
+	if math.Abs(delta-expectedLock) > expectedLock*0.01 {
+		t.Errorf("USDT locked moved by %.8f between place and cancel, expected about %.8f (price %.2f x qty %g)",
+			delta, expectedLock, price, quantity)
+	}
+
+	t.Logf("✅ outboundAccountPosition: USDT locked %.8f after place, %.8f after cancel (delta %.8f)",
+		placedLocked, canceledLocked, delta)
+}
+
+// awaitAssetLocked waits for the next outboundAccountPosition that reports asset and returns its locked balance
+func awaitAssetLocked(positions <-chan *models.OutboundAccountPositionEvent, asset string) (float64, error) {
+	timeout := time.After(balanceEventWait)
+	for {
+		select {
+		case event := <-positions:
+			for _, balance := range event.Balances {
+				if balance.Asset != asset {
+					continue
+				}
+				if _, err := strconv.ParseFloat(balance.Free, 64); err != nil {
+					return 0, fmt.Errorf("unparseable %s free balance %q: %w", asset, balance.Free, err)
+				}
+				locked, err := strconv.ParseFloat(balance.Locked, 64)
+				if err != nil {
+					return 0, fmt.Errorf("unparseable %s locked balance %q: %w", asset, balance.Locked, err)
+				}
+				return locked, nil
+			}
+		case <-timeout:
+			return 0, fmt.Errorf("no event reporting %s within %s", asset, balanceEventWait)
+		}
+	}
+}
+
+// subscribeUserDataStream subscribes an already logged-on session to its user data events
+func subscribeUserDataStream(client *spotws.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responseChan := make(chan error, 1)
+
+	err := client.SendUserDataStreamSubscribe(ctx,
+		models.NewUserDataStreamSubscribeRequest(),
+		func(response *models.UserDataStreamSubscribeResponse, err error) error {
+			responseChan <- err
+			return err
+		})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-responseChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// placeRestingOrder places a GTC LIMIT BUY on BTCUSDT and returns its order id
+func placeRestingOrder(client *spotws.Client, price, quantity string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	orderChan := make(chan *models.OrderPlaceResponse, 1)
+	errChan := make(chan error, 1)
+
+	err := client.SendOrderPlace(ctx,
+		models.NewOrderPlaceRequest().
+			SetSymbol("BTCUSDT").
+			SetSide("BUY").
+			SetType("LIMIT").
+			SetTimeInForce("GTC").
+			SetQuantity(quantity).
+			SetPrice(price),
+		func(response *models.OrderPlaceResponse, err error) error {
+			if err != nil {
+				errChan <- err
+			} else {
+				orderChan <- response
+			}
+			return err
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case response := <-orderChan:
+		if response.Result == nil {
+			return 0, fmt.Errorf("received nil result in order response")
+		}
+		return response.Result.OrderId, nil
+	case err := <-errChan:
+		return 0, err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// cancelRestingOrder cancels a BTCUSDT order by id
+func cancelRestingOrder(client *spotws.Client, orderID int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responseChan := make(chan error, 1)
+
+	err := client.SendOrderCancel(ctx,
+		models.NewOrderCancelRequest().
+			SetSymbol("BTCUSDT").
+			SetOrderId(orderID),
+		func(response *models.OrderCancelResponse, err error) error {
+			responseChan <- err
+			return err
+		})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-responseChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
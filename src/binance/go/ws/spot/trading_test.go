@@ -3,6 +3,7 @@ package wstest
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -98,6 +99,24 @@ func TestOrderListPlaceOto(t *testing.T) {
 	}
 }
 
+// wsTradingEnvVar enables WS API tests that leave working orders on the testnet book
+const wsTradingEnvVar = "BINANCE_TEST_WS_TRADING"
+
+// TestOrderListOcoLifecycle places an OCO over an Ed25519 session and cancels it via orderList.cancel
+func TestOrderListOcoLifecycle(t *testing.T) {
+	if os.Getenv(wsTradingEnvVar) != "true" {
+		t.Skipf("WS order list lifecycle disabled. Set %s=true to enable", wsTradingEnvVar)
+	}
+	for _, config := range getTestConfigs() {
+		if config.KeyType != KeyTypeED25519 || config.AuthType != AuthTypeTRADE {
+			continue // session.logon requires Ed25519 keys
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testOrderListOcoLifecycle(t, config)
+		})
+	}
+}
+
 // Implementation functions
 func testNewOrderTest(client *spotws.Client, config TestConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -472,3 +491,182 @@ func testOrderListPlaceOto(client *spotws.Client, config TestConfig) error {
 		return ctx.Err()
 	}
 }
+
+func testOrderListOcoLifecycle(t *testing.T, config TestConfig) {
+	testSuite.rateLimit.Wait()
+
+	// Connect without client-side credentials so placement and cancel are
+	// authorized only through the session established by session.logon
+	sessionConfig := config
+	sessionConfig.APIKey = ""
+	sessionConfig.SecretKey = ""
+	sessionConfig.PrivateKey = ""
+
+	client, err := setupClient(sessionConfig)
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+	defer client.Disconnect()
+	defer func() {
+		if err := sendSessionLogout(client); err != nil {
+			t.Logf("Cleanup session logout failed: %v", err)
+		}
+	}()
+
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Session logon failed: %v", err)
+	}
+
+	currentPrice, err := getCurrentPrice(client, "BTCUSDT")
+	if err != nil {
+		t.Fatalf("Failed to get ticker price for OCO: %v", err)
+	}
+
+	// Buy OCO: the limit maker leg rests below the market, the stop leg triggers above it
+	belowPrice := fmt.Sprintf("%.2f", currentPrice*0.95)
+	stopPrice := fmt.Sprintf("%.2f", currentPrice*1.05)
+	abovePrice := fmt.Sprintf("%.2f", currentPrice*1.06)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	placeID := fmt.Sprintf("oco-place-%d", time.Now().UnixNano())
+	placeChan := make(chan *models.OrderListPlaceOcoResponse, 1)
+	placeErrChan := make(chan error, 1)
+
+	err = client.SendOrderListPlaceOco(ctx,
+		models.NewOrderListPlaceOcoRequest().
+			SetId(placeID).
+			SetSymbol("BTCUSDT").
+			SetSide("BUY").
+			SetQuantity("0.001").
+			SetAboveType("STOP_LOSS_LIMIT").
+			SetAboveStopPrice(stopPrice).
+			SetAbovePrice(abovePrice).
+			SetAboveTimeInForce("GTC").
+			SetBelowType("LIMIT_MAKER").
+			SetBelowPrice(belowPrice),
+		func(response *models.OrderListPlaceOcoResponse, err error) error {
+			if err != nil {
+				placeErrChan <- err
+			} else {
+				placeChan <- response
+			}
+			return err
+		})
+	if err != nil {
+		t.Fatalf("Failed to send orderList.place.oco: %v", err)
+	}
+
+	var placed *models.OrderListPlaceOcoResponse
+	select {
+	case placed = <-placeChan:
+	case err := <-placeErrChan:
+		t.Fatalf("orderList.place.oco failed: %v", err)
+	case <-ctx.Done():
+		t.Fatal("orderList.place.oco timeout")
+	}
+
+	if placed.Id != placeID {
+		t.Errorf("Place response id %q does not match request id %q", placed.Id, placeID)
+	}
+	if placed.Result == nil {
+		t.Fatal("Received nil result in orderList.place.oco response")
+	}
+	orderListID := placed.Result.OrderListId
+
+	canceled := false
+	defer func() {
+		if canceled {
+			return
+		}
+		if _, err := cancelOrderList(client, orderListID, ""); err != nil {
+			t.Logf("Cleanup cancel of order list %d failed: %v", orderListID, err)
+		}
+	}()
+
+	if placed.Result.ContingencyType != "OCO" {
+		t.Errorf("Expected contingencyType OCO, got %q", placed.Result.ContingencyType)
+	}
+	if len(placed.Result.Orders) != 2 {
+		t.Fatalf("Expected 2 OCO legs, got %d", len(placed.Result.Orders))
+	}
+	if len(placed.Result.OrderReports) != 2 {
+		t.Fatalf("Expected 2 OCO order reports, got %d", len(placed.Result.OrderReports))
+	}
+	legTypes := make(map[string]bool)
+	for _, report := range placed.Result.OrderReports {
+		if report.OrderListId != orderListID {
+			t.Errorf("Leg %d has orderListId %d, want shared %d", report.OrderId, report.OrderListId, orderListID)
+		}
+		legTypes[report.Type] = true
+	}
+	if !legTypes["STOP_LOSS_LIMIT"] || !legTypes["LIMIT_MAKER"] {
+		t.Errorf("Expected STOP_LOSS_LIMIT and LIMIT_MAKER legs, got %v", legTypes)
+	}
+	t.Logf("Placed OCO order list %d with legs %d and %d",
+		orderListID, placed.Result.Orders[0].OrderId, placed.Result.Orders[1].OrderId)
+
+	cancelID := fmt.Sprintf("oco-cancel-%d", time.Now().UnixNano())
+	canceledList, err := cancelOrderList(client, orderListID, cancelID)
+	if err != nil {
+		t.Fatalf("orderList.cancel failed: %v", err)
+	}
+	canceled = true
+
+	if canceledList.Id != cancelID {
+		t.Errorf("Cancel response id %q does not match request id %q", canceledList.Id, cancelID)
+	}
+	if canceledList.Result == nil {
+		t.Fatal("Received nil result in orderList.cancel response")
+	}
+	if canceledList.Result.OrderListId != orderListID {
+		t.Errorf("Canceled order list %d, want %d", canceledList.Result.OrderListId, orderListID)
+	}
+	if canceledList.Result.ListOrderStatus != "ALL_DONE" {
+		t.Errorf("Expected listOrderStatus ALL_DONE after cancel, got %q", canceledList.Result.ListOrderStatus)
+	}
+	for _, report := range canceledList.Result.OrderReports {
+		if report.Status != "CANCELED" {
+			t.Errorf("Leg %d has status %q after cancel, want CANCELED", report.OrderId, report.Status)
+		}
+	}
+}
+
+// cancelOrderList cancels a BTCUSDT order list, tagging the request with id when one is given
+func cancelOrderList(client *spotws.Client, orderListID int64, id string) (*models.OrderListCancelResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responseChan := make(chan *models.OrderListCancelResponse, 1)
+	errChan := make(chan error, 1)
+
+	request := models.NewOrderListCancelRequest().
+		SetSymbol("BTCUSDT").
+		SetOrderListId(orderListID)
+	if id != "" {
+		request.SetId(id)
+	}
+
+	err := client.SendOrderListCancel(ctx, request,
+		func(response *models.OrderListCancelResponse, err error) error {
+			if err != nil {
+				errChan <- err
+			} else {
+				responseChan <- response
+			}
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case response := <-responseChan:
+		return response, nil
+	case err := <-errChan:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -2,6 +2,11 @@ package streamstest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -479,4 +484,414 @@ checkResults:
 	if err := client.Unsubscribe(ctx, []string{"btcusdt@aggTrade"}); err != nil {
 		t.Errorf("Failed to unsubscribe: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+// TestCombinedStreamInitialAttach tests attaching streams through the combined
+// stream URL at connect time, without a separate SUBSCRIBE request
+func TestCombinedStreamInitialAttach(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping combined stream initial attach test in short mode")
+	}
+
+	client := umfuturesstreams.NewClient()
+
+	err := client.SetActiveServer("testnet1")
+	if err != nil {
+		t.Fatalf("Failed to set testnet server: %v", err)
+	}
+
+	// Streams attached through the URL path
+	expected := map[string]bool{
+		"btcusdt@aggTrade":   true,
+		"ethusdt@bookTicker": true,
+	}
+	streamPath := "btcusdt@aggTrade/ethusdt@bookTicker"
+
+	var mu sync.Mutex
+	streamCounts := make(map[string]int)
+	var mismatches []string
+	eventsMu := make(chan struct{}, 100)
+
+	// Only the combined handler is registered so every event arrives with its envelope
+	client.HandleCombinedStreamEvent(func(event *models.CombinedStreamEvent) error {
+		mismatch := combinedEnvelopeMismatch(event)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case !expected[event.StreamName]:
+			mismatches = append(mismatches, "unexpected stream "+event.StreamName)
+		case mismatch != nil:
+			mismatches = append(mismatches, mismatch.Error())
+		}
+		streamCounts[event.StreamName]++
+
+		select {
+		case eventsMu <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Streams are attached via the URL path, no Subscribe call follows
+	if err := client.ConnectToCombinedStreams(ctx, streamPath); err != nil {
+		t.Fatalf("Failed to connect to combined streams %s: %v", streamPath, err)
+	}
+	defer client.Disconnect()
+
+	t.Logf("Waiting for events on connect-time streams: %s", streamPath)
+	timeout := time.After(20 * time.Second)
+
+waitLoop:
+	for {
+		select {
+		case <-eventsMu:
+			mu.Lock()
+			done := len(streamCounts) == len(expected)
+			mu.Unlock()
+			if done {
+				break waitLoop
+			}
+		case <-timeout:
+			t.Log("Timeout reached while waiting for connect-time stream events")
+			break waitLoop
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, mismatch := range mismatches {
+		t.Errorf("Envelope mismatch: %s", mismatch)
+	}
+
+	for stream := range expected {
+		count := streamCounts[stream]
+		if count == 0 {
+			t.Errorf("No events received for connect-time stream %s", stream)
+			continue
+		}
+		t.Logf("Stream %s delivered %d events", stream, count)
+	}
+
+	if len(mismatches) == 0 && len(streamCounts) == len(expected) {
+		t.Log("✅ Connect-time combined stream attach working")
+	}
+}
+
+// clientEventCounts tallies the events one client received, split into the
+// stream it owns and the stream owned by the other client
+type clientEventCounts struct {
+	own     int64
+	foreign int64
+}
+
+// TestSingleAndCombinedClientsCoexist runs a single-stream and a combined-stream client
+// side by side and checks that each client's handlers only see its own stream
+func TestSingleAndCombinedClientsCoexist(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping single and combined client coexistence test in short mode")
+	}
+
+	single := umfuturesstreams.NewClient()
+	if err := single.SetActiveServer("testnet1"); err != nil {
+		t.Fatalf("Failed to set testnet server for single client: %v", err)
+	}
+	combined := umfuturesstreams.NewClient()
+	if err := combined.SetActiveServer("testnet1"); err != nil {
+		t.Fatalf("Failed to set testnet server for combined client: %v", err)
+	}
+
+	// The single client owns BTCUSDT aggTrade; the combined client owns ETHUSDT bookTicker.
+	// Both register both handlers so a leak through shared handler state is counted.
+	var singleCounts, combinedCounts clientEventCounts
+	single.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+		if event.Symbol == "BTCUSDT" {
+			atomic.AddInt64(&singleCounts.own, 1)
+		} else {
+			atomic.AddInt64(&singleCounts.foreign, 1)
+		}
+		return nil
+	})
+	single.HandleBookTickerEvent(func(event *models.BookTickerEvent) error {
+		atomic.AddInt64(&singleCounts.foreign, 1)
+		return nil
+	})
+	combined.HandleBookTickerEvent(func(event *models.BookTickerEvent) error {
+		if event.Symbol == "ETHUSDT" {
+			atomic.AddInt64(&combinedCounts.own, 1)
+		} else {
+			atomic.AddInt64(&combinedCounts.foreign, 1)
+		}
+		return nil
+	})
+	combined.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+		atomic.AddInt64(&combinedCounts.foreign, 1)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Connect both clients concurrently to exercise any shared setup state
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if err := single.Connect(ctx); err != nil {
+			errs <- fmt.Errorf("single client connect: %w", err)
+			return
+		}
+		if err := single.Subscribe(ctx, []string{"btcusdt@aggTrade"}); err != nil {
+			errs <- fmt.Errorf("single client subscribe: %w", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := combined.ConnectToCombinedStreams(ctx, "ethusdt@bookTicker"); err != nil {
+			errs <- fmt.Errorf("combined client connect: %w", err)
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	defer single.Disconnect()
+	defer combined.Disconnect()
+
+	for err := range errs {
+		t.Fatalf("Failed to start clients: %v", err)
+	}
+
+	deadline := time.Now().Add(eventWait())
+	for time.Now().Before(deadline) {
+		if atomic.LoadInt64(&singleCounts.own) >= 3 && atomic.LoadInt64(&combinedCounts.own) >= 3 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	singleOwn, singleForeign := atomic.LoadInt64(&singleCounts.own), atomic.LoadInt64(&singleCounts.foreign)
+	combinedOwn, combinedForeign := atomic.LoadInt64(&combinedCounts.own), atomic.LoadInt64(&combinedCounts.foreign)
+	t.Logf("Single client: %d own, %d foreign events; combined client: %d own, %d foreign events",
+		singleOwn, singleForeign, combinedOwn, combinedForeign)
+
+	if singleOwn == 0 {
+		t.Error("Single client received no btcusdt@aggTrade events")
+	}
+	if combinedOwn == 0 {
+		t.Error("Combined client received no ethusdt@bookTicker events")
+	}
+	if singleForeign != 0 {
+		t.Errorf("Single client received %d events belonging to the combined client", singleForeign)
+	}
+	if combinedForeign != 0 {
+		t.Errorf("Combined client received %d events belonging to the single client", combinedForeign)
+	}
+
+	if singleOwn > 0 && combinedOwn > 0 && singleForeign == 0 && combinedForeign == 0 {
+		t.Log("✅ Single and combined clients deliver events independently")
+	}
+}
+
+// combinedStreamEventTypes maps a stream name suffix to the event type its payload carries
+var combinedStreamEventTypes = []struct {
+	prefix    string
+	eventType string
+}{
+	{"aggTrade", "aggTrade"},
+	{"markPrice", "markPriceUpdate"},
+	{"kline_", "kline"},
+	{"miniTicker", "24hrMiniTicker"},
+	{"ticker", "24hrTicker"},
+	{"bookTicker", "bookTicker"},
+	{"forceOrder", "forceOrder"},
+	{"depth", "depthUpdate"},
+}
+
+// combinedEnvelopeMismatch checks that a combined stream wrapper's data carries
+// the event type and symbol named by its stream field
+func combinedEnvelopeMismatch(event *models.CombinedStreamEvent) error {
+	symbol, suffix, ok := strings.Cut(event.StreamName, "@")
+	if !ok || symbol == "" || suffix == "" {
+		return fmt.Errorf("stream %q is not of the form <symbol>@<stream>", event.StreamName)
+	}
+
+	var payload struct {
+		EventType string `json:"e"`
+		Symbol    string `json:"s"`
+	}
+	dataBytes, err := json.Marshal(event.StreamData)
+	if err == nil {
+		err = json.Unmarshal(dataBytes, &payload)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode data for stream %s: %v", event.StreamName, err)
+	}
+
+	// Unknown stream kinds are still checked for the symbol
+	wantType := ""
+	for _, candidate := range combinedStreamEventTypes {
+		if suffix == candidate.prefix || strings.HasPrefix(suffix, candidate.prefix) {
+			wantType = candidate.eventType
+			break
+		}
+	}
+
+	if wantType != "" && payload.EventType != "" && payload.EventType != wantType {
+		return fmt.Errorf("stream %s carried event type %s, want %s", event.StreamName, payload.EventType, wantType)
+	}
+	if !strings.EqualFold(payload.Symbol, symbol) {
+		return fmt.Errorf("stream %s carried symbol %q, want %s", event.StreamName, payload.Symbol, strings.ToUpper(symbol))
+	}
+	return nil
+}
+
+// TestCombinedStreamEnvelopeConsistency tests that every multiplexed wrapper's
+// stream name matches the event type and symbol in its data
+func TestCombinedStreamEnvelopeConsistency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping combined stream envelope consistency test in short mode")
+	}
+
+	client := umfuturesstreams.NewClient()
+
+	err := client.SetActiveServer("testnet1")
+	if err != nil {
+		t.Fatalf("Failed to set testnet server: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := client.ConnectToCombinedStreams(ctx, ""); err != nil {
+		t.Fatalf("Failed to connect to combined streams: %v", err)
+	}
+	defer client.Disconnect()
+
+	streams := []string{
+		"btcusdt@aggTrade",
+		"ethusdt@aggTrade",
+		"btcusdt@kline_1m",
+		"ethusdt@bookTicker",
+		"btcusdt@markPrice",
+		"ethusdt@miniTicker",
+	}
+
+	var mu sync.Mutex
+	streamCounts := make(map[string]int)
+	var mismatches []string
+
+	client.HandleCombinedStreamEvent(func(event *models.CombinedStreamEvent) error {
+		mismatch := combinedEnvelopeMismatch(event)
+
+		mu.Lock()
+		defer mu.Unlock()
+		streamCounts[event.StreamName]++
+		if mismatch != nil {
+			mismatches = append(mismatches, mismatch.Error())
+		}
+		return nil
+	})
+
+	if err := client.Subscribe(ctx, streams); err != nil {
+		t.Fatalf("Failed to subscribe to streams: %v", err)
+	}
+
+	t.Logf("Checking combined stream envelopes for %v...", eventWait())
+	time.Sleep(eventWait())
+
+	if err := client.Unsubscribe(ctx, streams); err != nil {
+		t.Errorf("Failed to unsubscribe: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	total := 0
+	for stream, count := range streamCounts {
+		total += count
+		t.Logf("Stream %s: %d wrapper events", stream, count)
+	}
+	if total == 0 {
+		t.Skip("No combined stream events received (may be due to low market activity)")
+	}
+
+	for _, mismatch := range mismatches {
+		t.Errorf("Envelope mismatch: %s", mismatch)
+	}
+	if len(mismatches) > 0 {
+		t.Fatalf("%d of %d wrapper events had a stream/data mismatch", len(mismatches), total)
+	}
+
+	t.Logf("✅ All %d wrapper events matched their stream names across %d streams", total, len(streamCounts))
+}
+
+// propertyAckTimeout bounds the wait for a GET_PROPERTY response
+const propertyAckTimeout = 5 * time.Second
+
+// TestCombinedPropertyMatchesEndpoint tests that GET_PROPERTY combined reports true on a /stream
+// connection and false on a /ws connection. A missing reply skips rather than fails, as the
+// server does not always acknowledge property requests on testnet.
+func TestCombinedPropertyMatchesEndpoint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping combined property test in short mode")
+	}
+
+	endpoints := []struct {
+		name     string
+		combined bool
+	}{
+		{"CombinedEndpoint", true},
+		{"SingleEndpoint", false},
+	}
+
+	for _, endpoint := range endpoints {
+		t.Run(endpoint.name, func(t *testing.T) {
+			client, err := NewStreamTestClientDedicated(getTestConfig())
+			if err != nil {
+				t.Fatalf("Failed to create dedicated test client: %v", err)
+			}
+			defer client.Disconnect()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			if endpoint.combined {
+				client.SetupEventHandlersForCombinedStreams()
+				err = client.ConnectToCombinedStreams(ctx)
+			} else {
+				client.SetupEventHandlers()
+				err = client.Connect(ctx)
+			}
+			if err != nil {
+				t.Fatalf("Failed to connect: %v", err)
+			}
+
+			if err := client.GetProperty(ctx, "combined"); err != nil {
+				t.Fatalf("GET_PROPERTY combined failed: %v", err)
+			}
+
+			var combined, answered bool
+			deadline := time.Now().Add(propertyAckTimeout)
+			for time.Now().Before(deadline) {
+				if combined, answered = latestResult[bool](client.GetEventsByType("subscriptionResponse")); answered {
+					break
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+			if !answered {
+				t.Logf("No GET_PROPERTY reply within %v on the %s", propertyAckTimeout, endpoint.name)
+				t.Skip("Server did not acknowledge GET_PROPERTY combined")
+			}
+
+			if combined != endpoint.combined {
+				t.Errorf("GET_PROPERTY combined returned %v on the %s, expected %v",
+					combined, endpoint.name, endpoint.combined)
+			}
+			t.Logf("✅ combined=%v on the %s", combined, endpoint.name)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package streamstest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openxapi/binance-go/ws/umfutures-streams/models"
+)
+
+const (
+	// wsCompressionEnvVar enables the live compression test, which needs a server that negotiates permessage-deflate
+	wsCompressionEnvVar = "BINANCE_TEST_WS_COMPRESSION"
+	// compressedStream is a high-volume stream so decoding is exercised on many frames
+	compressedStream = "btcusdt@depth@100ms"
+	// compressedEventsWanted is how many decoded events the test waits for
+	compressedEventsWanted = 50
+)
+
+// parseExtensions returns the extension tokens listed in a Sec-WebSocket-Extensions header
+func parseExtensions(header string) []string {
+	var extensions []string
+	for _, part := range strings.Split(header, ",") {
+		token := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if token != "" {
+			extensions = append(extensions, token)
+		}
+	}
+	return extensions
+}
+
+// offeredExtensions connects an SDK client to a local frame server and returns the extensions
+// the client offered in its upgrade request; the SDK exposes no accessor for them
+func offeredExtensions(t *testing.T) []string {
+	t.Helper()
+	fs := newFrameServer(t, []byte(`{}`))
+	client := newFrameServerClient(t, fs)
+	connectFrameServerClient(t, client)
+
+	handshakes := fs.handshakeHeaders()
+	if len(handshakes) != 1 {
+		t.Fatalf("Expected 1 upgrade request from the SDK client, got %d", len(handshakes))
+	}
+	return parseExtensions(handshakes[0].Get("Sec-WebSocket-Extensions"))
+}
+
+// offersDeflate reports whether permessage-deflate is among the offered extensions
+func offersDeflate(extensions []string) bool {
+	for _, extension := range extensions {
+		if extension == "permessage-deflate" {
+			return true
+		}
+	}
+	return false
+}
+
+// TestCompressionOffer documents whether the SDK client offers permessage-deflate when it
+// connects. It runs against a local server, so it needs no network.
+func TestCompressionOffer(t *testing.T) {
+	extensions := offeredExtensions(t)
+	for _, extension := range extensions {
+		if !strings.HasPrefix(extension, "permessage-") && !strings.HasPrefix(extension, "x-") {
+			t.Errorf("SDK client offered unexpected extension %q", extension)
+		}
+	}
+
+	if offersDeflate(extensions) {
+		t.Logf("✅ SDK client offers permessage-deflate (offered: %v)", extensions)
+	} else {
+		t.Logf("SDK client does not offer permessage-deflate (offered: %v); streams are always received uncompressed", extensions)
+	}
+}
+
+// TestCompressionNegotiation decodes a high-volume stream through the SDK client's handlers on
+// a live connection. It only runs when the SDK offers permessage-deflate, since otherwise the
+// connection is never compressed. The SDK does not report what the server accepted, so the
+// negotiated extension itself cannot be asserted.
+func TestCompressionNegotiation(t *testing.T) {
+	if os.Getenv(wsCompressionEnvVar) != "true" {
+		t.Skipf("Compression test disabled. Set %s=true to enable", wsCompressionEnvVar)
+	}
+	if extensions := offeredExtensions(t); !offersDeflate(extensions) {
+		t.Skipf("SDK client does not offer permessage-deflate (offered: %v); nothing to negotiate", extensions)
+	}
+
+	client, err := setupClient(getTestConfig())
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+
+	var decoded, implausible int64
+	done := make(chan struct{})
+	client.HandleDiffDepthEvent(func(event *models.DiffDepthEvent) error {
+		if event.Symbol != "BTCUSDT" || event.FirstUpdateId > event.FinalUpdateId {
+			atomic.AddInt64(&implausible, 1)
+			t.Logf("Implausible depth event: symbol=%q U=%d u=%d", event.Symbol, event.FirstUpdateId, event.FinalUpdateId)
+		}
+		if atomic.AddInt64(&decoded, 1) == compressedEventsWanted {
+			close(done)
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventWait()+10*time.Second)
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	if err := client.Subscribe(ctx, []string{compressedStream}); err != nil {
+		t.Fatalf("Failed to subscribe to %s: %v", compressedStream, err)
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatalf("Decoded only %d of %d %s events with compression offered", atomic.LoadInt64(&decoded), compressedEventsWanted, compressedStream)
+	}
+	if n := atomic.LoadInt64(&implausible); n > 0 {
+		t.Errorf("%d of %d %s events decoded implausibly", n, compressedEventsWanted, compressedStream)
+	}
+
+	t.Logf("✅ Decoded %d %s events through the SDK client with permessage-deflate offered", compressedEventsWanted, compressedStream)
+}
+
+// TestParseExtensions tests parsing of the Sec-WebSocket-Extensions header
+func TestParseExtensions(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", nil},
+		{"permessage-deflate", []string{"permessage-deflate"}},
+		{"permessage-deflate; server_no_context_takeover; client_no_context_takeover", []string{"permessage-deflate"}},
+		{"permessage-deflate, x-custom; a=1", []string{"permessage-deflate", "x-custom"}},
+	}
+
+	for _, tt := range tests {
+		got := parseExtensions(tt.header)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("parseExtensions(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
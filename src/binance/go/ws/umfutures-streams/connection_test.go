@@ -249,4 +249,4 @@ func TestConnectionRecovery(t *testing.T) {
 	if err := client.Disconnect(); err != nil {
 		t.Errorf("Failed to disconnect: %v", err)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,186 @@
+package streamstest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	umfuturesstreams "github.com/openxapi/binance-go/ws/umfutures-streams"
+	"github.com/openxapi/binance-go/ws/umfutures-streams/models"
+)
+
+// Captured raw frames used by the decode benchmarks. They are replayed from testdata through
+// a local frame server so the benchmarks are network-free and deterministic.
+const (
+	aggTradeFrameFile  = "aggTrade.json"
+	tickerArrFrameFile = "ticker_arr.json"
+	// recordFramesEnvVar re-records the frames from the live mainnet streams when set to "true"
+	recordFramesEnvVar = "BINANCE_TEST_RECORD_FRAMES"
+	// replayTimeout bounds how long replayed frames may take to reach the handlers
+	replayTimeout = 30 * time.Second
+)
+
+// recordedFrames maps each testdata file to the stream it is recorded from
+var recordedFrames = []struct {
+	file   string
+	stream string
+}{
+	{aggTradeFrameFile, "btcusdt@aggTrade"},
+	{tickerArrFrameFile, "!ticker@arr"},
+}
+
+// loadFrame reads a captured raw stream frame from testdata
+func loadFrame(tb testing.TB, name string) []byte {
+	tb.Helper()
+	frame, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatalf("Failed to load frame %s: %v", name, err)
+	}
+	return frame
+}
+
+// replay writes frames copies of the server's frame and waits until the handlers have
+// counted want more events
+func replay(tb testing.TB, fs *frameServer, handled *int64, frames int, want int64) {
+	tb.Helper()
+	target := atomic.LoadInt64(handled) + want
+	fs.send <- frames
+
+	deadline := time.Now().Add(replayTimeout)
+	for atomic.LoadInt64(handled) < target {
+		if time.Now().After(deadline) {
+			tb.Fatalf("Handlers saw %d of %d replayed events within %v", atomic.LoadInt64(handled)-(target-want), want, replayTimeout)
+		}
+		time.Sleep(50 * time.Microsecond)
+	}
+}
+
+// tickerCount returns how many ticker events a !ticker@arr frame carries
+func tickerCount(tb testing.TB, frame []byte) int64 {
+	tb.Helper()
+	var entries []json.RawMessage
+	if err := json.Unmarshal(frame, &entries); err != nil {
+		tb.Fatalf("Frame %s is not a JSON array: %v", tickerArrFrameFile, err)
+	}
+	return int64(len(entries))
+}
+
+// TestRecordFrames re-records the testdata frames from the live mainnet streams. It is opt-in
+// so the committed frames stay fixed between runs.
+func TestRecordFrames(t *testing.T) {
+	if os.Getenv(recordFramesEnvVar) != "true" {
+		t.Skipf("Frame recording disabled. Set %s=true to re-record testdata", recordFramesEnvVar)
+	}
+
+	server := umfuturesstreams.NewClient().GetServer("mainnet1")
+	if server == nil {
+		t.Fatal("Mainnet server not found")
+	}
+	for _, rf := range recordedFrames {
+		frame, err := recordFrame(server.URL+"/"+rf.stream, 30*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to record %s: %v", rf.stream, err)
+		}
+		if err := os.WriteFile(filepath.Join("testdata", rf.file), frame, 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", rf.file, err)
+		}
+		t.Logf("Recorded %d bytes from %s into testdata/%s", len(frame), rf.stream, rf.file)
+	}
+}
+
+// TestDecodeFrames replays each captured frame once through the SDK client and checks the
+// registered handlers receive populated events, so a schema change surfaces here rather than
+// as a silently faster benchmark
+func TestDecodeFrames(t *testing.T) {
+	t.Run("aggTrade", func(t *testing.T) {
+		fs := newFrameServer(t, loadFrame(t, aggTradeFrameFile))
+		client := newFrameServerClient(t, fs)
+
+		var handled int64
+		var last atomic.Pointer[models.AggregateTradeEvent]
+		client.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+			last.Store(event)
+			atomic.AddInt64(&handled, 1)
+			return nil
+		})
+		connectFrameServerClient(t, client)
+
+		replay(t, fs, &handled, 1, 1)
+		event := last.Load()
+		if event.EventType != "aggTrade" || event.Symbol == "" || event.AggregateTradeId == 0 || event.TradeTime == 0 {
+			t.Errorf("Unexpected aggTrade event: type=%q symbol=%q id=%d tradeTime=%d",
+				event.EventType, event.Symbol, event.AggregateTradeId, event.TradeTime)
+		}
+	})
+
+	t.Run("tickerArr", func(t *testing.T) {
+		frame := loadFrame(t, tickerArrFrameFile)
+		want := tickerCount(t, frame)
+		if want == 0 {
+			t.Fatal("Expected ticker entries in !ticker@arr frame")
+		}
+		fs := newFrameServer(t, frame)
+		client := newFrameServerClient(t, fs)
+
+		var handled, malformed int64
+		client.HandleTickerEvent(func(event *models.TickerEvent) error {
+			if event.EventType != "24hrTicker" || event.Symbol == "" {
+				atomic.AddInt64(&malformed, 1)
+			}
+			atomic.AddInt64(&handled, 1)
+			return nil
+		})
+		connectFrameServerClient(t, client)
+
+		replay(t, fs, &handled, 1, want)
+		if malformed := atomic.LoadInt64(&malformed); malformed > 0 {
+			t.Errorf("%d of %d ticker events were missing their type or symbol", malformed, want)
+		}
+	})
+}
+
+// BenchmarkDecodeAggTrade measures read, decode and dispatch of aggTrade frames through the SDK
+// client to a registered handler. The local server's writes are included but allocate little.
+func BenchmarkDecodeAggTrade(b *testing.B) {
+	frame := loadFrame(b, aggTradeFrameFile)
+	fs := newFrameServer(b, frame)
+	client := newFrameServerClient(b, fs)
+
+	var handled int64
+	client.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+		atomic.AddInt64(&handled, 1)
+		return nil
+	})
+	connectFrameServerClient(b, client)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(frame)))
+	b.ResetTimer()
+
+	replay(b, fs, &handled, b.N, int64(b.N))
+}
+
+// BenchmarkDecodeTickerArr measures read, decode and dispatch of !ticker@arr frames, which carry
+// one ticker per symbol and stress allocation the most
+func BenchmarkDecodeTickerArr(b *testing.B) {
+	frame := loadFrame(b, tickerArrFrameFile)
+	perFrame := tickerCount(b, frame)
+	fs := newFrameServer(b, frame)
+	client := newFrameServerClient(b, fs)
+
+	var handled int64
+	client.HandleTickerEvent(func(event *models.TickerEvent) error {
+		atomic.AddInt64(&handled, 1)
+		return nil
+	})
+	connectFrameServerClient(b, client)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(frame)))
+	b.ResetTimer()
+
+	replay(b, fs, &handled, b.N, int64(b.N)*perFrame)
+}
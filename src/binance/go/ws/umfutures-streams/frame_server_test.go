@@ -0,0 +1,220 @@
+package streamstest
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	umfuturesstreams "github.com/openxapi/binance-go/ws/umfutures-streams"
+)
+
+// websocketGUID is the RFC 6455 suffix used to derive Sec-WebSocket-Accept from the client key
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// websocketAccept returns the Sec-WebSocket-Accept value for a client key
+func websocketAccept(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// textFrameHeader returns the header of an unmasked, final text frame carrying n payload bytes
+func textFrameHeader(n int) []byte {
+	switch {
+	case n < 126:
+		return []byte{0x81, byte(n)}
+	case n <= 0xFFFF:
+		header := []byte{0x81, 126, 0, 0}
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+		return header
+	default:
+		header := []byte{0x81, 127, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+		return header
+	}
+}
+
+// frameServer is a local WebSocket endpoint that writes a recorded frame on demand, so the
+// frame reaches the SDK client's read loop and registered handlers without the network.
+// It speaks just enough RFC 6455 to upgrade and send text frames, and never negotiates extensions.
+type frameServer struct {
+	server *httptest.Server
+	frame  []byte
+	send   chan int
+
+	mu         sync.Mutex
+	handshakes []http.Header
+}
+
+// newFrameServer starts a server that writes frame n times for every n sent on its channel
+func newFrameServer(tb testing.TB, frame []byte) *frameServer {
+	tb.Helper()
+	fs := &frameServer{frame: frame, send: make(chan int)}
+	header := textFrameHeader(len(frame))
+
+	fs.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Sec-WebSocket-Key")
+		if key == "" || !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			http.Error(w, "websocket upgrade required", http.StatusBadRequest)
+			return
+		}
+		fs.mu.Lock()
+		fs.handshakes = append(fs.handshakes, r.Header.Clone())
+		fs.mu.Unlock()
+
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", websocketAccept(key))
+		if rw.Flush() != nil {
+			return
+		}
+
+		// Control frames from the client (pings, close) are read and discarded
+		go io.Copy(io.Discard, conn)
+
+		for n := range fs.send {
+			for i := 0; i < n; i++ {
+				rw.Write(header)
+				rw.Write(fs.frame)
+			}
+			if rw.Flush() != nil {
+				return
+			}
+		}
+	}))
+	tb.Cleanup(func() {
+		close(fs.send)
+		fs.server.Close()
+	})
+	return fs
+}
+
+// url returns the ws:// address of the server
+func (fs *frameServer) url() string {
+	return "ws" + strings.TrimPrefix(fs.server.URL, "http") + "/ws"
+}
+
+// handshakeHeaders returns the upgrade request headers of every connection accepted so far
+func (fs *frameServer) handshakeHeaders() []http.Header {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return append([]http.Header(nil), fs.handshakes...)
+}
+
+// newFrameServerClient returns an SDK client whose active server is fs; handlers should be
+// registered before connectFrameServerClient so no replayed frame is missed
+func newFrameServerClient(tb testing.TB, fs *frameServer) *umfuturesstreams.Client {
+	tb.Helper()
+	client := umfuturesstreams.NewClient()
+	if err := client.AddOrUpdateServer("replay", fs.url(), "Replay Server", "Local recorded-frame server"); err != nil {
+		tb.Fatalf("Failed to add replay server: %v", err)
+	}
+	if err := client.SetActiveServer("replay"); err != nil {
+		tb.Fatalf("Failed to activate replay server: %v", err)
+	}
+	return client
+}
+
+// connectFrameServerClient connects client to its frame server and disconnects it on cleanup
+func connectFrameServerClient(tb testing.TB, client *umfuturesstreams.Client) {
+	tb.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		tb.Fatalf("Failed to connect to replay server: %v", err)
+	}
+	tb.Cleanup(func() { client.Disconnect() })
+}
+
+// recordFrame connects to a live wss:// stream URL without the SDK and returns the payload of
+// the first text message exactly as the server sent it. No extensions are offered, so the
+// payload is never compressed.
+func recordFrame(rawURL string, timeout time.Duration) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", host, &tls.Config{ServerName: u.Hostname()})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+	fmt.Fprintf(conn, "GET %s HTTP/1.1\r\nHost: %s\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Key: %s\r\nSec-WebSocket-Version: 13\r\n\r\n",
+		u.RequestURI(), u.Host, key)
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Sec-WebSocket-Accept") != websocketAccept(key) {
+		return nil, fmt.Errorf("upgrade to %s refused: %s", rawURL, resp.Status)
+	}
+
+	var message []byte
+	for {
+		var head [2]byte
+		if _, err := io.ReadFull(reader, head[:]); err != nil {
+			return nil, err
+		}
+		length := uint64(head[1] & 0x7F)
+		switch length {
+		case 126:
+			var ext [2]byte
+			if _, err := io.ReadFull(reader, ext[:]); err != nil {
+				return nil, err
+			}
+			length = uint64(binary.BigEndian.Uint16(ext[:]))
+		case 127:
+			var ext [8]byte
+			if _, err := io.ReadFull(reader, ext[:]); err != nil {
+				return nil, err
+			}
+			length = binary.BigEndian.Uint64(ext[:])
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			return nil, err
+		}
+
+		// Keep text frames and their continuations; skip pings and other control frames
+		switch opcode := head[0] & 0x0F; opcode {
+		case 0x1, 0x0:
+			message = append(message, payload...)
+			if head[0]&0x80 != 0 {
+				return message, nil
+			}
+		case 0x8:
+			return nil, fmt.Errorf("server closed the connection before sending a message")
+		}
+	}
+}
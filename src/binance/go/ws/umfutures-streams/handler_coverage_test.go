@@ -0,0 +1,178 @@
+package streamstest
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// defaultRequiredHandlers are the event types expected to fire during a full suite run
+// against an active USD-M market. Override with BINANCE_TEST_REQUIRED_HANDLERS.
+var defaultRequiredHandlers = []string{
+	"aggTrade",
+	"markPrice",
+	"kline",
+	"continuousKline",
+	"miniTicker",
+	"ticker",
+	"bookTicker",
+	"depthUpdate",
+}
+
+// HandlerCoverage counts events per type across every StreamTestClient in the run
+type HandlerCoverage struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// handlerCoverage is the suite-wide tracker fed by StreamTestClient.recordEvent
+var handlerCoverage = NewHandlerCoverage()
+
+// NewHandlerCoverage creates an empty coverage tracker
+func NewHandlerCoverage() *HandlerCoverage {
+	return &HandlerCoverage{counts: make(map[string]int)}
+}
+
+// Record notes that an event of eventType was delivered to its handler
+func (hc *HandlerCoverage) Record(eventType string) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.counts[eventType]++
+}
+
+// Count returns how many events of eventType were recorded
+func (hc *HandlerCoverage) Count(eventType string) int {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	return hc.counts[eventType]
+}
+
+// Missing returns the required event types that never fired, in the given order
+func (hc *HandlerCoverage) Missing(required []string) []string {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	var missing []string
+	for _, eventType := range required {
+		if hc.counts[eventType] == 0 {
+			missing = append(missing, eventType)
+		}
+	}
+	return missing
+}
+
+// nonMarketEventTypes are recorded alongside market data but do not show the market is active:
+// control messages, and combined stream envelopes whose payload is recorded under its own type
+var nonMarketEventTypes = map[string]bool{
+	"subscriptionResponse": true,
+	"error":                true,
+	"combinedStream":       true,
+}
+
+// MarketActive reports whether any market data event arrived, ignoring non-market records
+func (hc *HandlerCoverage) MarketActive() bool {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	for eventType, count := range hc.counts {
+		if !nonMarketEventTypes[eventType] && count > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// requiredHandlers returns the configured required event types
+func requiredHandlers() []string {
+	value := os.Getenv("BINANCE_TEST_REQUIRED_HANDLERS")
+	if value == "" {
+		return defaultRequiredHandlers
+	}
+
+	var required []string
+	for _, eventType := range strings.Split(value, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			required = append(required, eventType)
+		}
+	}
+	return required
+}
+
+// reportHandlerCoverage logs per-type event counts and, in strict mode, fails if a
+// required handler never fired even though the market was active.
+func reportHandlerCoverage(t *testing.T, hc *HandlerCoverage, required []string) {
+	t.Helper()
+
+	hc.mu.Lock()
+	eventTypes := make([]string, 0, len(hc.counts))
+	for eventType := range hc.counts {
+		eventTypes = append(eventTypes, eventType)
+	}
+	hc.mu.Unlock()
+	for _, eventType := range required {
+		if hc.Count(eventType) == 0 {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	sort.Strings(eventTypes)
+
+	t.Log("\n📡 HANDLER COVERAGE")
+	for _, eventType := range eventTypes {
+		status := "✅"
+		if hc.Count(eventType) == 0 {
+			status = "❌"
+		}
+		t.Logf("  %s %-22s %d events", status, eventType, hc.Count(eventType))
+	}
+
+	missing := hc.Missing(required)
+	if len(missing) == 0 {
+		return
+	}
+
+	t.Logf("⚠️  Required handlers that never fired: %s", strings.Join(missing, ", "))
+	if !strictMode() {
+		return
+	}
+	if !hc.MarketActive() {
+		t.Log("Market inactive - no market data events received, not enforcing handler coverage")
+		return
+	}
+	t.Errorf("Required handlers never fired despite an active market: %s", strings.Join(missing, ", "))
+}
+
+// TestHandlerCoverageTracking verifies the coverage tracker logic without a connection
+func TestHandlerCoverageTracking(t *testing.T) {
+	hc := NewHandlerCoverage()
+	if hc.MarketActive() {
+		t.Fatal("Empty tracker should not report an active market")
+	}
+
+	hc.Record("subscriptionResponse")
+	hc.Record("error")
+	hc.Record("combinedStream")
+	if hc.MarketActive() {
+		t.Fatal("Control messages and combined stream envelopes alone should not count as market activity")
+	}
+
+	hc.Record("aggTrade")
+	hc.Record("aggTrade")
+	if !hc.MarketActive() {
+		t.Fatal("Market data events should mark the market active")
+	}
+	if got := hc.Count("aggTrade"); got != 2 {
+		t.Fatalf("Expected 2 aggTrade events, got %d", got)
+	}
+
+	missing := hc.Missing([]string{"aggTrade", "kline", "ticker"})
+	if strings.Join(missing, ",") != "kline,ticker" {
+		t.Fatalf("Expected kline and ticker to be missing, got %v", missing)
+	}
+
+	t.Setenv("BINANCE_TEST_REQUIRED_HANDLERS", " kline , markPrice,,")
+	if got := strings.Join(requiredHandlers(), ","); got != "kline,markPrice" {
+		t.Fatalf("Unexpected required handlers from env: %s", got)
+	}
+}
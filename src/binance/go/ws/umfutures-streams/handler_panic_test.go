@@ -0,0 +1,113 @@
+package streamstest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openxapi/binance-go/ws/umfutures-streams/models"
+)
+
+const (
+	// handlerPanicChildEnvVar marks the re-executed test binary that hosts the panicking handler
+	handlerPanicChildEnvVar = "UMFUTURES_HANDLER_PANIC_CHILD"
+	// handlerPanicMarker is the panic value, searched for in the child output to confirm it was surfaced
+	handlerPanicMarker = "injected-handler-failure"
+	// handlerPanicEventsAfter is how many events must arrive after the panic
+	handlerPanicEventsAfter = 3
+)
+
+// TestHandlerPanicRecovery verifies a panicking event handler does not kill the read loop.
+// An unrecovered panic in the client's goroutine would take down the whole test binary,
+// so the scenario runs in a re-executed child process and the parent inspects how it exited.
+func TestHandlerPanicRecovery(t *testing.T) {
+	if os.Getenv(handlerPanicChildEnvVar) == "1" {
+		runHandlerPanicChild(t)
+		return
+	}
+
+	if testing.Short() {
+		t.Skip("Skipping handler panic recovery test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventWaitLong()+30*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestHandlerPanicRecovery$", "-test.v")
+	cmd.Env = append(os.Environ(), handlerPanicChildEnvVar+"=1")
+	output, err := cmd.CombinedOutput()
+	out := string(output)
+
+	if ctx.Err() != nil {
+		t.Fatalf("Handler panic child timed out:\n%s", out)
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if strings.Contains(out, "panic: "+handlerPanicMarker) {
+			t.Fatalf("SDK does not recover handler panics: the panic escaped the read loop and crashed the process:\n%s", out)
+		}
+		t.Fatalf("Handler panic child failed (exit code %d):\n%s", exitErr.ExitCode(), out)
+	}
+	if err != nil {
+		t.Fatalf("Failed to run handler panic child: %v", err)
+	}
+
+	// The child only passes once events flowed after the panic; the panic itself
+	// must also have been reported rather than swallowed silently
+	if !strings.Contains(out, handlerPanicMarker) {
+		t.Errorf("Handler panic was recovered but not surfaced in logs or error output:\n%s", out)
+	}
+
+	t.Log("✅ Client recovered from handler panic and kept delivering events")
+}
+
+// runHandlerPanicChild connects a dedicated client whose aggTrade handler panics on the
+// first event, then waits for further events to prove the read loop survived
+func runHandlerPanicChild(t *testing.T) {
+	client, err := setupClient(getTestConfig())
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+
+	var delivered int32
+	client.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+		if atomic.AddInt32(&delivered, 1) == 1 {
+			panic(handlerPanicMarker)
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventWaitLong())
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	if err := client.Subscribe(ctx, []string{"btcusdt@aggTrade"}); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Only %d aggTrade events delivered; expected %d after the handler panic",
+				atomic.LoadInt32(&delivered), handlerPanicEventsAfter+1)
+		case <-ticker.C:
+			if atomic.LoadInt32(&delivered) > handlerPanicEventsAfter {
+				t.Logf("Received %d aggTrade events after the handler panic", atomic.LoadInt32(&delivered)-1)
+				return
+			}
+		}
+	}
+}
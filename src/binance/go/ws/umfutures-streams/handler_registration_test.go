@@ -0,0 +1,174 @@
+package streamstest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openxapi/binance-go/ws/umfutures-streams/models"
+)
+
+const (
+	// registrationGoroutines is how many goroutines register handlers concurrently with dispatch
+	registrationGoroutines = 4
+	// registrationsPerGoroutine is how many times each goroutine re-registers its handler
+	registrationsPerGoroutine = 25
+	// registrationEventsWanted is how many events each handler must see before the test checks counts
+	registrationEventsWanted = 3
+	// sequentialStream is a high-rate stream so handler invocations arrive back to back
+	sequentialStream = "btcusdt@depth@100ms"
+	// sequentialEventsWanted is how many events the handler must see before overlap is judged
+	sequentialEventsWanted = 30
+	// sequentialHandlerDelay keeps each invocation busy so an overlapping dispatch would be caught
+	sequentialHandlerDelay = 20 * time.Millisecond
+)
+
+// waitForCount polls counter until it reaches want or the deadline passes
+func waitForCount(counter *int64, want int64, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if atomic.LoadInt64(counter) >= want {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return atomic.LoadInt64(counter) >= want
+}
+
+// TestConcurrentHandlerRegistration registers handlers from several goroutines while the read
+// loop is dispatching events, then checks the new handler receives events and the original
+// handler never stopped. Run with -race to catch unsynchronized access to the handler registry.
+func TestConcurrentHandlerRegistration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping concurrent handler registration test in short mode")
+	}
+
+	client, err := setupClient(getTestConfig())
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+
+	var aggTrades, bookTickers int64
+	client.HandleAggregateTradeEvent(func(event *models.AggregateTradeEvent) error {
+		atomic.AddInt64(&aggTrades, 1)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventWaitLong())
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	// bookTicker is subscribed up front but has no handler until the goroutines below register one
+	if err := client.Subscribe(ctx, []string{"btcusdt@aggTrade", "btcusdt@bookTicker"}); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	if !waitForCount(&aggTrades, registrationEventsWanted, eventWait()) {
+		t.Fatalf("Only %d aggTrade events before registration; the stream is not delivering", atomic.LoadInt64(&aggTrades))
+	}
+
+	aggTradesBefore := atomic.LoadInt64(&aggTrades)
+	var wg sync.WaitGroup
+	for g := 0; g < registrationGoroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < registrationsPerGoroutine; i++ {
+				client.HandleBookTickerEvent(func(event *models.BookTickerEvent) error {
+					atomic.AddInt64(&bookTickers, 1)
+					return nil
+				})
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !waitForCount(&bookTickers, registrationEventsWanted, eventWait()) {
+		t.Errorf("Handler registered during streaming received %d bookTicker events, want at least %d",
+			atomic.LoadInt64(&bookTickers), registrationEventsWanted)
+	}
+	if !waitForCount(&aggTrades, aggTradesBefore+registrationEventsWanted, eventWait()) {
+		t.Errorf("Original aggTrade handler stopped firing after concurrent registration: %d events before, %d after",
+			aggTradesBefore, atomic.LoadInt64(&aggTrades))
+	}
+
+	t.Logf("Registered %d handlers concurrently; aggTrade events %d -> %d, bookTicker events %d",
+		registrationGoroutines*registrationsPerGoroutine, aggTradesBefore, atomic.LoadInt64(&aggTrades), atomic.LoadInt64(&bookTickers))
+}
+
+// TestSequentialHandlerInvocation checks that events from one stream are delivered to their
+// handler one at a time and in arrival order, so handlers need no locking of their own for
+// per-stream state. Run with -race to catch unsynchronized access on the dispatch path.
+func TestSequentialHandlerInvocation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping sequential handler invocation test in short mode")
+	}
+
+	client, err := setupClient(getTestConfig())
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+
+	var (
+		mu         sync.Mutex
+		inHandler  bool
+		overlaps   int
+		outOfOrder int
+		lastFinal  int64
+		received   int64
+	)
+	client.HandleDiffDepthEvent(func(event *models.DiffDepthEvent) error {
+		mu.Lock()
+		if inHandler {
+			overlaps++
+		}
+		inHandler = true
+		if event.FinalUpdateId <= lastFinal {
+			outOfOrder++
+		}
+		lastFinal = event.FinalUpdateId
+		mu.Unlock()
+
+		time.Sleep(sequentialHandlerDelay)
+
+		mu.Lock()
+		inHandler = false
+		mu.Unlock()
+		atomic.AddInt64(&received, 1)
+		return nil
+	})
+
+	// Each event holds the handler for sequentialHandlerDelay, so allow for the backlog to drain
+	ctx, cancel := context.WithTimeout(context.Background(), eventWaitLong()+sequentialEventsWanted*sequentialHandlerDelay)
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	if err := client.Subscribe(ctx, []string{sequentialStream}); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	if !waitForCount(&received, sequentialEventsWanted, eventWaitLong()+sequentialEventsWanted*sequentialHandlerDelay) {
+		t.Fatalf("Only %d %s events received, want at least %d", atomic.LoadInt64(&received), sequentialStream, sequentialEventsWanted)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if overlaps > 0 {
+		t.Errorf("Handler was re-entered %d times for %s; invocations for one stream must not overlap", overlaps, sequentialStream)
+	}
+	if outOfOrder > 0 {
+		t.Errorf("%d %s events reached the handler out of update ID order", outOfOrder, sequentialStream)
+	}
+
+	t.Logf("Handled %d %s events sequentially: %d overlaps, %d out of order",
+		atomic.LoadInt64(&received), sequentialStream, overlaps, outOfOrder)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -294,6 +295,11 @@ func (stc *StreamTestClient) ListSubscriptions(ctx context.Context) error {
 	return stc.client.ListSubscriptions(ctx)
 }
 
+// GetProperty requests a connection property; the value arrives as a subscription response
+func (stc *StreamTestClient) GetProperty(ctx context.Context, property string) error {
+	return stc.client.GetProperty(ctx, property)
+}
+
 // GetActiveStreams returns currently active streams
 func (stc *StreamTestClient) GetActiveStreams() []string {
 	stc.streamsMu.RLock()
@@ -429,6 +435,7 @@ func (stc *StreamTestClient) recordEvent(eventType string, data interface{}) {
 	}
 
 	stc.eventsReceived = append(stc.eventsReceived, event)
+	handlerCoverage.Record(eventType)
 	log.Printf("Received %s event: %+v", eventType, data)
 }
 
@@ -518,6 +525,41 @@ func getTestConfig() TestConfig {
 	}
 }
 
+// strictEnvVar promotes selected warnings to failures when set to "true"
+const strictEnvVar = "BINANCE_TEST_STRICT"
+
+// strictMode reports whether warnings raised through warnf should fail the test
+func strictMode() bool {
+	return os.Getenv(strictEnvVar) == "true"
+}
+
+// warnf reports a condition that is tolerated locally but should fail strict (CI) runs
+func warnf(t testing.TB, format string, args ...interface{}) {
+	t.Helper()
+	if strictMode() {
+		t.Errorf("[strict] "+format, args...)
+		return
+	}
+	t.Logf("⚠️  Warning: "+format, args...)
+}
+
+// warnRecorder captures warnf output without failing the enclosing test
+type warnRecorder struct {
+	testing.TB
+	errors []string
+	logs   []string
+}
+
+func (r *warnRecorder) Helper() {}
+
+func (r *warnRecorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *warnRecorder) Logf(format string, args ...interface{}) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
 // TB interface for both testing.T and testing.B
 type TB interface {
 	Fatalf(format string, args ...interface{})
@@ -779,7 +821,7 @@ func testStreamSubscription(t *testing.T, streamName string, eventType string, e
 
 	// Wait for events
 	t.Logf("Waiting for %s events...", eventType)
-	err = client.WaitForEventsByType(eventType, eventCount, 15*time.Second)
+	err = client.WaitForEventsByType(eventType, eventCount, eventWait())
 	if err != nil {
 		t.Fatalf("Failed to receive %s events: %v", eventType, err)
 	}
@@ -795,6 +837,9 @@ func testStreamSubscription(t *testing.T, streamName string, eventType string, e
 
 	t.Logf("✅ Successfully received %d %s events", len(events), eventType)
 
+	// Trade events must be emitted no earlier than the trade they report
+	validateTradeTimestamps(t, events)
+
 	// Unsubscribe
 	if err := client.Unsubscribe(ctx, []string{streamName}); err != nil {
 		t.Errorf("Failed to unsubscribe from %s: %v", streamName, err)
@@ -814,4 +859,5 @@ func testStreamSubscription(t *testing.T, streamName string, eventType string, e
 	} else {
 		t.Errorf("Stream %s still found in active streams after unsubscribe", streamName)
 	}
-}
\ No newline at end of file
+}
+
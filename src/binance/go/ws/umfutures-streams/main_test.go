@@ -13,6 +13,12 @@ import (
 func TestMain(m *testing.M) {
 	flag.Parse()
 
+	// Fail fast on an unusable proxy instead of timing out every connection
+	if err := configureWSProxy(); err != nil {
+		fmt.Printf("Invalid proxy configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run the tests
 	code := m.Run()
 
@@ -123,9 +129,11 @@ func TestFullIntegrationSuite(t *testing.T) {
 
 		// Basic stream tests
 		{"AggregateTradeStream", TestAggregateTradeStream, true},
+		{"AggTradeRESTConsistency", TestAggTradeRESTConsistency, false},
 		{"MarkPriceStream", TestMarkPriceStream, true},
 		{"KlineStream", TestKlineStream, true},
 		{"ContinuousKlineStream", TestContinuousKlineStream, true},
+		{"KlineClosedSemantics", TestKlineClosedSemantics, false},
 		{"MiniTickerStream", TestMiniTickerStream, true},
 		{"TickerStream", TestTickerStream, true},
 		{"BookTickerStream", TestBookTickerStream, true},
@@ -158,19 +166,30 @@ func TestFullIntegrationSuite(t *testing.T) {
 		{"SubscriptionManagement", TestSubscriptionManagement, true},
 		{"MultipleStreamsSubscription", TestMultipleStreamsSubscription, true},
 		{"StreamUnsubscription", TestStreamUnsubscription, true},
+		{"DuplicateSubscription", TestDuplicateSubscription, false},
 
 		// Error handling tests
 		{"ErrorHandling", TestErrorHandling, true},
 		{"InvalidStreamNames", TestInvalidStreamNames, true},
+		{"HandlerPanicRecovery", TestHandlerPanicRecovery, true},
+		{"ConcurrentHandlerRegistration", TestConcurrentHandlerRegistration, false},
+		{"SequentialHandlerInvocation", TestSequentialHandlerInvocation, false},
 
 		// Combined streams tests
 		{"CombinedStreamEventReception", TestCombinedStreamEventReception, true},
 		{"CombinedStreamEventDataTypes", TestCombinedStreamEventDataTypes, true},
 		{"CombinedStreamSubscriptionManagement", TestCombinedStreamSubscriptionManagement, true},
+		{"CombinedStreamInitialAttach", TestCombinedStreamInitialAttach, true},
+		{"CombinedStreamEnvelopeConsistency", TestCombinedStreamEnvelopeConsistency, true},
+		{"SingleAndCombinedClientsCoexist", TestSingleAndCombinedClientsCoexist, true},
+		{"CombinedPropertyMatchesEndpoint", TestCombinedPropertyMatchesEndpoint, false},
 
 		// Performance tests
 		{"ConcurrentStreams", TestConcurrentStreams, false},
 		{"HighVolumeStreams", TestHighVolumeStreams, false},
+		{"DecodeFrames", TestDecodeFrames, true},
+		{"CompressionOffer", TestCompressionOffer, false},
+		{"CompressionNegotiation", TestCompressionNegotiation, false},
 	}
 
 	for _, testFunc := range testFunctions {
@@ -210,5 +229,7 @@ func TestFullIntegrationSuite(t *testing.T) {
 		}
 	}
 
+	reportHandlerCoverage(t, handlerCoverage, requiredHandlers())
+
 	t.Log(strings.Repeat("=", 80))
 }
\ No newline at end of file
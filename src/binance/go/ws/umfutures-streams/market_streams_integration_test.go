@@ -2,7 +2,10 @@ package streamstest
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -682,12 +685,14 @@ func testLiquidationStreamIntegration(t *testing.T) {
 		t.Fatalf("Failed to set testnet server: %v", err)
 	}
 
-	eventsReceived := 0
-	var lastEvent models.LiquidationEvent
+	var mu sync.Mutex
+	var events []models.LiquidationEvent
 
 	client.HandleLiquidationEvent(func(event *models.LiquidationEvent) error {
-		eventsReceived++
-		lastEvent = *event
+		mu.Lock()
+		events = append(events, *event)
+		eventsReceived := len(events)
+		mu.Unlock()
 		if event.LiquidationOrder != nil {
 			t.Logf("Received liquidation event #%d: Symbol=%s, Side=%s, Price=%s", 
 				eventsReceived, event.LiquidationOrder.Symbol, event.LiquidationOrder.Side, event.LiquidationOrder.Price)
@@ -716,15 +721,77 @@ func testLiquidationStreamIntegration(t *testing.T) {
 	// Wait for events
 	time.Sleep(6 * time.Second)
 
-	if eventsReceived == 0 {
+	mu.Lock()
+	received := append([]models.LiquidationEvent(nil), events...)
+	mu.Unlock()
+
+	if len(received) == 0 {
 		t.Log("No liquidation events received (expected on testnet - liquidations are rare)")
-	} else {
-		// Validate event structure (liquidation events might not be available on testnet)
-		if lastEvent.LiquidationOrder != nil && lastEvent.LiquidationOrder.Symbol == "" {
-			t.Error("Expected LiquidationOrder.Symbol to be non-empty")
+		return
+	}
+
+	// Validate the full order schema whenever data is available
+	now := time.Now()
+	for i := range received {
+		for _, violation := range liquidationOrderViolations(&received[i], now) {
+			t.Errorf("Liquidation event #%d: %s", i+1, violation)
+		}
+	}
+	t.Logf("Liquidation stream integration successful: %d events received", len(received))
+}
+
+// liquidationOrderStatuses are the statuses a forceOrder snapshot reports for a liquidation order
+var liquidationOrderStatuses = map[string]bool{"NEW": true, "FILLED": true}
+
+// liquidationOrderViolations describes every way a liquidation event's order deviates from the
+// documented forceOrder schema, or returns nil when it conforms
+func liquidationOrderViolations(event *models.LiquidationEvent, now time.Time) []string {
+	order := event.LiquidationOrder
+	if order == nil {
+		return []string{"missing liquidation order"}
+	}
+
+	var violations []string
+	if order.Symbol == "" {
+		violations = append(violations, "empty symbol")
+	}
+	if order.Side != "BUY" && order.Side != "SELL" {
+		violations = append(violations, fmt.Sprintf("side %q is not BUY or SELL", order.Side))
+	}
+	if order.OrderType != "LIMIT" {
+		violations = append(violations, fmt.Sprintf("order type %q, want LIMIT", order.OrderType))
+	}
+	if order.TimeInForce != "IOC" {
+		violations = append(violations, fmt.Sprintf("time in force %q, want IOC", order.TimeInForce))
+	}
+	if !liquidationOrderStatuses[order.OrderStatus] {
+		violations = append(violations, fmt.Sprintf("order status %q, want NEW or FILLED", order.OrderStatus))
+	}
+
+	for _, field := range []struct {
+		name     string
+		value    string
+		positive bool
+	}{
+		{"original quantity", order.OrigQty, true},
+		{"price", order.Price, true},
+		{"average price", order.AvgPrice, false},
+	} {
+		value, err := strconv.ParseFloat(field.value, 64)
+		switch {
+		case err != nil:
+			violations = append(violations, fmt.Sprintf("%s %q is not a decimal", field.name, field.value))
+		case value < 0:
+			violations = append(violations, fmt.Sprintf("%s %s is negative", field.name, field.value))
+		case field.positive && value == 0:
+			violations = append(violations, fmt.Sprintf("%s is zero", field.name))
 		}
-		t.Logf("Liquidation stream integration successful: %d events received", eventsReceived)
 	}
+
+	if age := now.Sub(time.UnixMilli(order.Time)); age > tradeEventMaxAge || age < -tradeEventMaxAge {
+		violations = append(violations, fmt.Sprintf("order time is %v away from local time", age.Round(time.Millisecond)))
+	}
+	return violations
 }
 
 // Depth Stream Tests
@@ -800,6 +867,48 @@ func testPartialDepthStreamIntegration(t *testing.T) {
 	}
 }
 
+// depthChainTracker checks the per-symbol diff depth update id chain: each event's pu must equal
+// the previous event's u, and U <= u must hold for every event
+type depthChainTracker struct {
+	mu        sync.Mutex
+	lastFinal map[string]int64
+	events    int
+	breaks    []string
+	malformed []string
+}
+
+func newDepthChainTracker() *depthChainTracker {
+	return &depthChainTracker{lastFinal: make(map[string]int64)}
+}
+
+// observe records one event; the first event of a symbol has no predecessor to compare against
+func (d *depthChainTracker) observe(symbol string, firstUpdateId, finalUpdateId, prevFinalUpdateId int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.events++
+	if symbol == "" {
+		d.malformed = append(d.malformed, fmt.Sprintf("event %d: empty Symbol", d.events))
+	}
+	if firstUpdateId == 0 {
+		d.malformed = append(d.malformed, fmt.Sprintf("event %d (%s): FirstUpdateId is zero", d.events, symbol))
+	}
+	if firstUpdateId > finalUpdateId {
+		d.breaks = append(d.breaks, fmt.Sprintf("%s: U=%d > u=%d", symbol, firstUpdateId, finalUpdateId))
+	}
+	if last, ok := d.lastFinal[symbol]; ok && prevFinalUpdateId != last {
+		d.breaks = append(d.breaks, fmt.Sprintf("%s: pu=%d, previous u=%d", symbol, prevFinalUpdateId, last))
+	}
+	d.lastFinal[symbol] = finalUpdateId
+}
+
+// snapshot returns the number of events seen, the continuity breaks found and the malformed events
+func (d *depthChainTracker) snapshot() (int, []string, []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.events, append([]string(nil), d.breaks...), append([]string(nil), d.malformed...)
+}
+
 func testDiffDepthStreamIntegration(t *testing.T) {
 	client := umfuturesstreams.NewClient()
 	err := client.SetActiveServer("testnet1")
@@ -807,14 +916,12 @@ func testDiffDepthStreamIntegration(t *testing.T) {
 		t.Fatalf("Failed to set testnet server: %v", err)
 	}
 
-	eventsReceived := 0
-	var lastEvent models.DiffDepthEvent
+	tracker := newDepthChainTracker()
 
 	client.HandleDiffDepthEvent(func(event *models.DiffDepthEvent) error {
-		eventsReceived++
-		lastEvent = *event
-		t.Logf("Received diff depth event #%d: Symbol=%s, FirstUpdateId=%d, FinalUpdateId=%d", 
-			eventsReceived, event.Symbol, event.FirstUpdateId, event.FinalUpdateId)
+		tracker.observe(event.Symbol, event.FirstUpdateId, event.FinalUpdateId, event.FinalUpdateIdInLastStream)
+		t.Logf("Received diff depth event: Symbol=%s, FirstUpdateId=%d, FinalUpdateId=%d, pu=%d", 
+			event.Symbol, event.FirstUpdateId, event.FinalUpdateId, event.FinalUpdateIdInLastStream)
 		return nil
 	})
 
@@ -837,18 +944,26 @@ func testDiffDepthStreamIntegration(t *testing.T) {
 	// Wait for events
 	time.Sleep(6 * time.Second)
 
+	eventsReceived, breaks, malformed := tracker.snapshot()
 	if eventsReceived == 0 {
 		t.Error("Expected to receive diff depth events")
-	} else {
-		// Validate event structure
-		if lastEvent.Symbol == "" {
-			t.Error("Expected Symbol to be non-empty")
-		}
-		if lastEvent.FirstUpdateId == 0 {
-			t.Error("Expected FirstUpdateId to be non-zero")
-		}
-		t.Logf("Diff depth stream integration successful: %d events received", eventsReceived)
+		return
 	}
+
+	// Validate event structure
+	for _, m := range malformed {
+		t.Errorf("Malformed diff depth event: %s", m)
+	}
+
+	// A local book can only be maintained if the update id chain is unbroken
+	for _, brk := range breaks {
+		t.Errorf("Diff depth continuity break: %s", brk)
+	}
+	if len(breaks) > 0 {
+		t.Errorf("%d continuity breaks in %d diff depth events", len(breaks), eventsReceived)
+		return
+	}
+	t.Logf("Diff depth stream integration successful: %d events received with an unbroken update id chain", eventsReceived)
 }
 
 func testDepthStreamUpdateSpeedIntegration(t *testing.T) {
@@ -1102,13 +1217,85 @@ func testAllArrayStreamsIntegration(t *testing.T) {
 	}
 }
 
-// Placeholder implementations for remaining complex test functions
-// (These would contain similar comprehensive testing patterns)
+// assetIndexNumericFields maps each numeric asset-index field to its raw string value
+func assetIndexNumericFields(event models.AssetIndexEvent) map[string]string {
+	return map[string]string{
+		"IndexPrice":            event.IndexPrice,
+		"BidBuffer":             event.BidBuffer,
+		"AskBuffer":             event.AskBuffer,
+		"BidRate":               event.BidRate,
+		"AskRate":               event.AskRate,
+		"AutoExchangeBidBuffer": event.AutoExchangeBidBuffer,
+		"AutoExchangeAskBuffer": event.AutoExchangeAskBuffer,
+		"AutoExchangeBidRate":   event.AutoExchangeBidRate,
+		"AutoExchangeAskRate":   event.AutoExchangeAskRate,
+	}
+}
 
 func testAssetIndexArrayStreamIntegration(t *testing.T) {
-	t.Log("Asset index array stream integration test (requires multi-assets mode)")
+	client := umfuturesstreams.NewClient()
+	err := client.SetActiveServer("testnet1")
+	if err != nil {
+		t.Fatalf("Failed to set testnet server: %v", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		events []models.AssetIndexEvent
+	)
+
+	// The array stream is delivered to the handler one asset-index entry at a time
+	client.HandleAssetIndexEvent(func(event *models.AssetIndexEvent) error {
+		mu.Lock()
+		events = append(events, *event)
+		mu.Unlock()
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Second)
+	defer cancel()
+
+	err = client.Connect(ctx)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	streams := []string{"!assetIndex@arr"}
+	err = client.Subscribe(ctx, streams)
+	if err != nil {
+		t.Fatalf("Failed to subscribe to asset index array stream: %v", err)
+	}
+
+	// Wait for events
+	time.Sleep(6 * time.Second)
+
+	mu.Lock()
+	received := append([]models.AssetIndexEvent(nil), events...)
+	mu.Unlock()
+
+	if len(received) == 0 {
+		t.Skip("No asset index array events received (requires multi-assets mode, not available on testnet)")
+	}
+
+	// Validate the full schema of every entry
+	for i, event := range received {
+		if event.Symbol == "" {
+			t.Errorf("Entry %d: expected Symbol to be non-empty", i)
+		}
+		for field, value := range assetIndexNumericFields(event) {
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				t.Errorf("Entry %d (%s): %s=%q is not a float: %v", i, event.Symbol, field, value, err)
+			}
+		}
+	}
+
+	t.Logf("Asset index array stream integration successful: %d entries validated", len(received))
 }
 
+// Placeholder implementations for remaining complex test functions
+// (These would contain similar comprehensive testing patterns)
+
 func testSingleStreamsConnectionIntegration(t *testing.T) {
 	client := umfuturesstreams.NewClient()
 	err := client.SetActiveServer("testnet1")
@@ -0,0 +1,38 @@
+package streamstest
+
+import (
+	"context"
+	"fmt"
+
+	umfutures "github.com/openxapi/binance-go/rest/umfutures"
+)
+
+// getRESTAggTradeIds fetches aggregate trade IDs for symbol between startTime and endTime (ms, inclusive)
+// from the USD-M futures REST API. The boolean reports whether the result hit the page limit.
+func getRESTAggTradeIds(symbol string, startTime, endTime int64) (map[int64]bool, bool, error) {
+	const limit = 1000
+
+	config := umfutures.NewConfiguration()
+	config.Host = "testnet.binancefuture.com"
+	config.Scheme = "https"
+
+	client := umfutures.NewAPIClient(config)
+
+	resp, _, err := client.FuturesAPI.GetAggTradesV1(context.Background()).
+		Symbol(symbol).
+		StartTime(startTime).
+		EndTime(endTime).
+		Limit(limit).
+		Execute()
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting aggregate trades: %v", err)
+	}
+
+	ids := make(map[int64]bool, len(resp))
+	for _, trade := range resp {
+		if trade.A != nil {
+			ids[*trade.A] = true
+		}
+	}
+	return ids, len(resp) >= limit, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 	"time"
+
+	"github.com/openxapi/binance-go/ws/umfutures-streams/models"
 )
 
 // TestSubscriptionManagement tests basic subscription management
@@ -371,4 +373,119 @@ func TestSubscriptionTracking(t *testing.T) {
 	}
 
 	t.Log("✅ Subscription tracking working correctly")
-}
\ No newline at end of file
+}
+
+// duplicateSubscriptionQuiet is how long aggTrade events may keep arriving after the unsubscribe
+// ACK before the stream counts as still active
+const duplicateSubscriptionQuiet = 2 * time.Second
+
+// duplicateAggTradeIds returns the aggregate trade ids delivered more than once
+func duplicateAggTradeIds(events []interface{}) []int64 {
+	seen := make(map[int64]int)
+	var duplicates []int64
+	for _, event := range events {
+		trade, ok := event.(*models.AggregateTradeEvent)
+		if !ok {
+			continue
+		}
+		seen[trade.AggregateTradeId]++
+		if seen[trade.AggregateTradeId] == 2 {
+			duplicates = append(duplicates, trade.AggregateTradeId)
+		}
+	}
+	return duplicates
+}
+
+// latestResult returns the result of the most recent subscription response whose result is a T.
+// The result type tells the replies apart: null for SUBSCRIBE, a bool for GET_PROPERTY and an
+// array of stream names for LIST_SUBSCRIPTIONS.
+func latestResult[T any](responses []interface{}) (T, bool) {
+	for i := len(responses) - 1; i >= 0; i-- {
+		response, ok := responses[i].(*models.SubscriptionResponse)
+		if !ok {
+			continue
+		}
+		if result, ok := response.AlwaysNullForSuccessfulSubscription.(T); ok {
+			return result, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// TestDuplicateSubscription tests that subscribing to the same stream twice is de-duplicated:
+// the server lists it once, each trade is delivered once, and a single unsubscribe stops it
+func TestDuplicateSubscription(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping duplicate subscription test in short mode")
+	}
+
+	client, isDedicated := setupTestClient(t)
+	if isDedicated {
+		defer client.Disconnect()
+	}
+
+	ctx := context.Background()
+	stream := "btcusdt@aggTrade"
+
+	for i := 1; i <= 2; i++ {
+		if err := client.Subscribe(ctx, []string{stream}); err != nil {
+			t.Fatalf("Subscribe #%d to %s failed: %v", i, stream, err)
+		}
+	}
+	subscribed := true
+	defer func() {
+		if subscribed {
+			client.Unsubscribe(ctx, []string{stream})
+		}
+	}()
+
+	// The server holds subscriptions as a set, so the duplicate should be listed once
+	if err := client.ListSubscriptions(ctx); err != nil {
+		t.Errorf("Failed to list subscriptions: %v", err)
+	} else {
+		time.Sleep(time.Second)
+		if listed, ok := latestResult[[]interface{}](client.GetEventsByType("subscriptionResponse")); ok {
+			count := 0
+			for _, name := range listed {
+				if name == stream {
+					count++
+				}
+			}
+			if count != 1 {
+				t.Errorf("Expected %s listed once after subscribing twice, listed %d times in %v", stream, count, listed)
+			}
+			t.Logf("Server subscriptions after duplicate subscribe: %v", listed)
+		} else {
+			t.Log("No LIST_SUBSCRIPTIONS result recorded; skipping the listing check")
+		}
+	}
+
+	client.ClearEvents()
+	if err := client.WaitForEventsByType("aggTrade", 20, eventWait()); err != nil {
+		t.Skipf("Not enough %s events to check for duplicate delivery: %v", stream, err)
+	}
+	events := client.GetEventsByType("aggTrade")
+	if duplicates := duplicateAggTradeIds(events); len(duplicates) > 0 {
+		t.Errorf("%d of %d aggTrade events were delivered more than once (ids %v); the duplicate subscription is not de-duplicated",
+			len(duplicates), len(events), duplicates)
+	}
+	t.Logf("Received %d aggTrade events with no duplicate deliveries", len(events))
+
+	// A single unsubscribe should remove the de-duplicated subscription entirely
+	if err := client.Unsubscribe(ctx, []string{stream}); err != nil {
+		t.Fatalf("Failed to unsubscribe from %s: %v", stream, err)
+	}
+	subscribed = false
+
+	time.Sleep(duplicateSubscriptionQuiet)
+	client.ClearEvents()
+	time.Sleep(eventWait() / 2)
+	if remaining := len(client.GetEventsByType("aggTrade")); remaining > 0 {
+		t.Errorf("Received %d aggTrade events after a single unsubscribe; the duplicate subscription is reference counted", remaining)
+		client.Unsubscribe(ctx, []string{stream})
+		return
+	}
+
+	t.Logf("✅ Duplicate subscription to %s listed once, delivered once, and removed by one unsubscribe", stream)
+}
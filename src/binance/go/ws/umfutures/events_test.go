@@ -3,6 +3,7 @@ package wstest
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -102,6 +103,17 @@ func TestSessionStatus(t *testing.T) {
 	}
 }
 
+func TestSessionRelogon(t *testing.T) {
+	for _, config := range getTestConfigs() {
+		if config.KeyType != KeyTypeED25519 || config.AuthType != AuthTypeUSER_DATA {
+			continue // session.logon requires Ed25519 keys
+		}
+		t.Run(config.Name, func(t *testing.T) {
+			testSessionRelogonCycle(t, config)
+		})
+	}
+}
+
 // Implementation functions
 
 func testSessionLogon(client *umfuturesws.Client, config TestConfig) error {
@@ -220,3 +232,68 @@ func testSessionStatus(client *umfuturesws.Client, config TestConfig) error {
 	}
 }
 
+// testSessionRelogonCycle verifies that an authenticated request relies on the
+// session after logon, fails once the session is logged out, and works again
+// after a second logon on the same connection.
+func testSessionRelogonCycle(t *testing.T, config TestConfig) {
+	testSuite.rateLimit.Wait()
+
+	// Connect without client-side credentials so that authenticated requests
+	// are only accepted through the session established by session.logon
+	sessionConfig := config
+	sessionConfig.APIKey = ""
+	sessionConfig.SecretKey = ""
+	sessionConfig.PrivateKey = ""
+
+	client, err := setupClient(sessionConfig)
+	if err != nil {
+		t.Fatalf("Failed to setup client: %v", err)
+	}
+	defer client.Disconnect()
+	defer func() {
+		if err := testSessionLogout(client, config); err != nil {
+			t.Logf("Cleanup session logout failed: %v", err)
+		}
+	}()
+
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Initial session logon failed: %v", err)
+	}
+	if err := testAccountStatus(client, config); err != nil {
+		t.Fatalf("Authenticated request after logon failed: %v", err)
+	}
+
+	if err := testSessionLogout(client, config); err != nil {
+		t.Fatalf("Session logout failed: %v", err)
+	}
+	err = testAccountStatus(client, config)
+	if err == nil {
+		t.Fatal("Expected authenticated request to fail after session logout")
+	}
+	if !isAuthError(err) {
+		t.Fatalf("Expected auth error after session logout, got: %v", err)
+	}
+	t.Logf("Authenticated request rejected after logout as expected: %v", err)
+
+	if err := testSessionLogon(client, config); err != nil {
+		t.Fatalf("Session re-logon failed: %v", err)
+	}
+	if err := testAccountStatus(client, config); err != nil {
+		t.Fatalf("Authenticated request after re-logon failed: %v", err)
+	}
+}
+
+// isAuthError reports whether err looks like a rejection for missing or
+// invalid credentials rather than a transport or timeout failure
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{"-1022", "-2014", "-2015", "-1102", "apikey", "api-key", "signature", "unauthorized", "authenticat"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}